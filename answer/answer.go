@@ -1,12 +1,17 @@
 package answer
 
 import (
+	"time"
+
 	"github.com/thalesfsp/configurer/util"
+	"github.com/thalesfsp/questionnaire/blob"
 	"github.com/thalesfsp/questionnaire/common"
 	"github.com/thalesfsp/questionnaire/errorcatalog"
 	"github.com/thalesfsp/questionnaire/internal/shared"
 	"github.com/thalesfsp/questionnaire/option"
 	"github.com/thalesfsp/questionnaire/question"
+	"github.com/thalesfsp/questionnaire/types"
+	"github.com/thalesfsp/questionnaire/validator"
 )
 
 //////
@@ -22,6 +27,35 @@ type Answer struct {
 
 	// Option is the Option at the time of the answer.
 	Option any `json:"option" bson:"option"`
+
+	// Attachment is the metadata of the respondent-provided file. Its content
+	// is in the blob store.
+	Attachment *blob.Metadata `json:"attachment,omitempty" bson:"attachment,omitempty"`
+
+	// Cells are the selected options - columns - per row of a matrix, indexed
+	// by row ID.
+	Cells map[string][]any `json:"cells,omitempty" bson:"cells,omitempty"`
+
+	// Dates are the respondent-provided date, or date-time - or the start, and
+	// the end of a date range.
+	Dates []time.Time `json:"dates,omitempty" bson:"dates,omitempty"`
+
+	// Number is the respondent-provided number, e.g.: age, weight.
+	Number *float64 `json:"number,omitempty" bson:"number,omitempty"`
+
+	// Options are the selected options at the time of the answer, e.g.:
+	// multiple-select - or the ranked ones, in order.
+	Options []any `json:"options,omitempty" bson:"options,omitempty"`
+
+	// Ranking is the IDs of the ranked options, in order, from the first
+	// position.
+	Ranking []string `json:"ranking,omitempty" bson:"ranking,omitempty"`
+
+	// Skipped is a flag to indicate if the question was explicitly skipped.
+	Skipped bool `json:"skipped" bson:"skipped"`
+
+	// Text is the respondent-provided free text.
+	Text string `json:"text,omitempty" bson:"text,omitempty"`
 }
 
 //////
@@ -43,22 +77,243 @@ func (a Answer) GetOption() any {
 	return a.Option
 }
 
-// GetOption returns the option at the time of the answer.
-//
-//nolint:forcetypeassert
+// GetOption returns the option at the time of the answer. It supports answers
+// loaded from storage (e.g. JSON). If the option isn't of type `T`, the zero
+// value is returned.
 func GetOption[T shared.N](a Answer) option.Option[T] {
-	return a.Option.(option.Option[T])
+	o, _ := option.ToOption[T](a.Option)
+
+	return o
+}
+
+// Value returns the value of the answer, e.g.: the chosen option's value, or
+// the free text, number, date - in the question's time zone, or attachment's
+// metadata. Matrices are
+// valued per row ID. Skipped answers have no value.
+func (a Answer) Value() any {
+	if a.Skipped {
+		return nil
+	}
+
+	if len(a.Options) > 0 {
+		return values(a.Options)
+	}
+
+	if len(a.Cells) > 0 {
+		rows := make(map[string]any, len(a.Cells))
+
+		for id := range a.Cells {
+			rows[id], _ = a.RowValue(id)
+		}
+
+		return rows
+	}
+
+	if a.Number != nil {
+		return *a.Number
+	}
+
+	if len(a.Dates) > 0 {
+		return a.dates()
+	}
+
+	if a.Attachment != nil {
+		return *a.Attachment
+	}
+
+	if a.Option == nil {
+		if a.Question.Type.IsInput() {
+			return a.Text
+		}
+
+		return nil
+	}
+
+	v, _ := option.ValueOf(a.Option)
+
+	return v
+}
+
+// RowValue returns the value of the row `id` of a matrix: the chosen option's
+// value, or the values of the options - if multiple. `ok` is false if the row
+// wasn't answered. Skipped matrices are answered without value (nil).
+func (a Answer) RowValue(id string) (any, bool) {
+	if a.Skipped {
+		return nil, true
+	}
+
+	cells, ok := a.Cells[id]
+	if !ok || len(cells) == 0 {
+		return nil, false
+	}
+
+	if a.Question.Meta.Matrix != nil && a.Question.Meta.Matrix.Multiple {
+		return values(cells), true
+	}
+
+	v, _ := option.ValueOf(cells[0])
+
+	return v, true
+}
+
+// Selected returns the chosen options: the option, the selected ones, or the
+// ones of each row of a matrix - in the order of the rows.
+func (a Answer) Selected() []any {
+	selected := []any{}
+
+	if a.Option != nil {
+		selected = append(selected, a.Option)
+	}
+
+	selected = append(selected, a.Options...)
+
+	if a.Question.Meta.Matrix != nil {
+		for _, r := range a.Question.Meta.Matrix.Rows {
+			selected = append(selected, a.Cells[r.ID]...)
+		}
+	}
+
+	return selected
+}
+
+// Feedback returns the feedback of the chosen options, e.g.: quizzes. Options
+// without feedback are ignored.
+func (a Answer) Feedback() []string {
+	feedback := []string{}
+
+	for _, raw := range a.Selected() {
+		opt, err := option.ToOption[any](raw)
+		if err != nil || opt.GetFeedback() == "" {
+			continue
+		}
+
+		feedback = append(feedback, opt.GetFeedback())
+	}
+
+	return feedback
+}
+
+// IsSkipped returns true if the question was explicitly skipped.
+func (a Answer) IsSkipped() bool {
+	return a.Skipped
+}
+
+// IsEmpty returns true if the answer has no option, nor value.
+func (a Answer) IsEmpty() bool {
+	return a.Option == nil && len(a.Options) == 0 && a.Text == "" && a.Number == nil &&
+		len(a.Dates) == 0 && len(a.Cells) == 0 && a.Attachment == nil
 }
 
 // Validate answer.
 func (a Answer) Validate() error {
-	if a.Question.Meta.Required && a.Option == nil {
+	if a.Question.Meta.Required && a.IsEmpty() {
 		return errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerOptionRequired)
 	}
 
+	if a.Question.Meta.Selection != nil {
+		if err := a.Question.Meta.Selection.Check(len(a.Options)); err != nil {
+			return err
+		}
+	}
+
+	if a.Text != "" && a.Question.Meta.Text != nil {
+		if err := a.Question.Meta.Text.Check(a.Text); err != nil {
+			return err
+		}
+	}
+
+	if a.Number != nil {
+		n := question.Number{}
+
+		if a.Question.Meta.Number != nil {
+			n = *a.Question.Meta.Number
+		}
+
+		if err := n.Check(*a.Number, a.Question.Type == types.Integer); err != nil {
+			return err
+		}
+	}
+
+	if a.Question.Type == types.Ranking {
+		r := question.Ranking{}
+
+		if a.Question.Meta.Ranking != nil {
+			r = *a.Question.Meta.Ranking
+		}
+
+		total := 0
+
+		if a.Question.Options != nil {
+			total = a.Question.Options.Size()
+		}
+
+		if err := r.Check(a.Ranking, total); err != nil {
+			return err
+		}
+	}
+
+	if a.Question.Meta.Matrix != nil && !a.Skipped {
+		counts := make(map[string]int, len(a.Cells))
+
+		for id, cells := range a.Cells {
+			counts[id] = len(cells)
+		}
+
+		if err := a.Question.Meta.Matrix.Check(counts, a.Question.Meta.Selection); err != nil {
+			return err
+		}
+	}
+
+	if a.Attachment != nil && a.Question.Meta.Attachment != nil {
+		if err := a.Question.Meta.Attachment.Check(a.Attachment.Size, a.Attachment.MIMEType); err != nil {
+			return err
+		}
+	}
+
+	if len(a.Dates) > 0 {
+		d := question.Date{}
+
+		if a.Question.Meta.Date != nil {
+			d = *a.Question.Meta.Date
+		}
+
+		if err := d.Check(a.Question.Type, a.Dates...); err != nil {
+			return err
+		}
+	}
+
+	// Presence is up to required questions, validators check provided values.
+	if len(a.Question.Meta.Validators) > 0 && !a.IsEmpty() && !a.Skipped {
+		if err := validator.Run(a.Question.GetID(), a.Value(), a.Question.Meta.Validators...); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// dates returns the value of date answers: the date, or the start, and the end
+// of a date range, in the question's time zone.
+func (a Answer) dates() any {
+	dates := make([]any, 0, len(a.Dates))
+
+	for _, d := range a.Dates {
+		if a.Question.Meta.Date != nil {
+			if loc, err := a.Question.Meta.Date.LoadLocation(); err == nil {
+				d = d.In(loc)
+			}
+		}
+
+		dates = append(dates, d)
+	}
+
+	if a.Question.Type == types.DateRange {
+		return dates
+	}
+
+	return dates[0]
+}
+
 //////
 // Factory.
 //
@@ -100,3 +355,126 @@ func MustNew(
 
 	return a
 }
+
+// NewText creates a new Answer with the respondent-provided free `text`. It's
+// normalized according to the question's text constraints, e.g.: trimmed.
+func NewText(q question.Question, text string) (Answer, error) {
+	a, err := New(q, nil)
+	if err != nil {
+		return Answer{}, err
+	}
+
+	if q.Meta.Text != nil {
+		text = q.Meta.Text.Normalize(text)
+	}
+
+	a.Text = text
+
+	return a, nil
+}
+
+// NewNumber creates a new Answer with the respondent-provided number `n`.
+func NewNumber(q question.Question, n float64) (Answer, error) {
+	a, err := New(q, nil)
+	if err != nil {
+		return Answer{}, err
+	}
+
+	a.Number = &n
+
+	return a, nil
+}
+
+// NewAttachment creates a new Answer with the metadata of the
+// respondent-provided file (`m`).
+func NewAttachment(q question.Question, m blob.Metadata) (Answer, error) {
+	a, err := New(q, nil)
+	if err != nil {
+		return Answer{}, err
+	}
+
+	a.Attachment = &m
+
+	return a, nil
+}
+
+// NewDates creates a new Answer with the respondent-provided `dates`: the
+// date, or date-time - or the start, and the end of a date range.
+func NewDates(q question.Question, dates []time.Time) (Answer, error) {
+	a, err := New(q, nil)
+	if err != nil {
+		return Answer{}, err
+	}
+
+	a.Dates = dates
+
+	return a, nil
+}
+
+// NewRanking creates a new Answer with the ranked `options` (`ids`), in order.
+func NewRanking(q question.Question, ids []string, options []any) (Answer, error) {
+	a, err := New(q, nil)
+	if err != nil {
+		return Answer{}, err
+	}
+
+	a.Options = options
+	a.Ranking = ids
+
+	return a, nil
+}
+
+// NewMatrix creates a new Answer with the selected options - columns - per row
+// of a matrix (`cells`).
+func NewMatrix(q question.Question, cells map[string][]any) (Answer, error) {
+	a, err := New(q, nil)
+	if err != nil {
+		return Answer{}, err
+	}
+
+	a.Cells = cells
+
+	return a, nil
+}
+
+// NewMany creates a new Answer with the selected `options`, e.g.:
+// multiple-select.
+func NewMany(q question.Question, options []any) (Answer, error) {
+	a, err := New(q, nil)
+	if err != nil {
+		return Answer{}, err
+	}
+
+	a.Options = options
+
+	return a, nil
+}
+
+// NewSkipped creates a new Answer for a question which was explicitly skipped.
+func NewSkipped(q question.Question) (Answer, error) {
+	a, err := New(q, nil)
+	if err != nil {
+		return Answer{}, err
+	}
+
+	a.Skipped = true
+
+	return a, nil
+}
+
+//////
+// Helpers.
+//////
+
+// values returns the values of the options.
+func values(options []any) []any {
+	values := make([]any, 0, len(options))
+
+	for _, o := range options {
+		v, _ := option.ValueOf(o)
+
+		values = append(values, v)
+	}
+
+	return values
+}
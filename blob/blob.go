@@ -0,0 +1,122 @@
+package blob
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+
+	"github.com/thalesfsp/customerror"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// sniffLength is the number of bytes used to detect the MIME type.
+const sniffLength = 512
+
+// Metadata of a stored blob.
+type Metadata struct {
+	// Hash is the SHA-256 hash of the content, hex encoded.
+	Hash string `json:"hash" bson:"hash"`
+
+	// Key is the storage key, used to retrieve the blob.
+	Key string `json:"key" bson:"key"`
+
+	// MIMEType is the MIME type, detected from the content - or the name.
+	MIMEType string `json:"mimeType" bson:"mimeType"`
+
+	// Name is the original name of the file.
+	Name string `json:"name" bson:"name"`
+
+	// Size in bytes.
+	Size int64 `json:"size" bson:"size"`
+}
+
+// Store stores blobs.
+type Store interface {
+	// Put stores the content `r` of the file `name`, returning its metadata.
+	Put(ctx context.Context, name string, r io.Reader) (Metadata, error)
+
+	// Get retrieves the content of the blob `key`. The caller must close it.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Delete the blob `key`.
+	Delete(ctx context.Context, key string) error
+}
+
+//////
+// Exported functionalities.
+//////
+
+// DetectMIMEType detects the MIME type from the first bytes of the content
+// (`head`). Unknown content falls back to the extension of the file `name`.
+func DetectMIMEType(name string, head []byte) string {
+	detected := http.DetectContentType(head)
+
+	if detected == "application/octet-stream" {
+		if byExt := mime.TypeByExtension(filepath.Ext(name)); byExt != "" {
+			detected = byExt
+		}
+	}
+
+	// Parameters, e.g.: charset, aren't part of the type.
+	if mediaType, _, err := mime.ParseMediaType(detected); err == nil {
+		return mediaType
+	}
+
+	return detected
+}
+
+// Stat returns the metadata of the blob `key`, stored in `s`, read from its
+// content. `name` is the original name of the file.
+func Stat(ctx context.Context, s Store, key, name string) (Metadata, error) {
+	r, err := s.Get(ctx, key)
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	defer r.Close()
+
+	h := sha256.New()
+	sn := &sniffer{}
+
+	size, err := io.Copy(io.MultiWriter(h, sn), r)
+	if err != nil {
+		return Metadata{}, customerror.NewFailedToError("read blob", customerror.WithError(err))
+	}
+
+	return Metadata{
+		Hash:     hex.EncodeToString(h.Sum(nil)),
+		Key:      key,
+		MIMEType: DetectMIMEType(name, sn.head),
+		Name:     filepath.Base(name),
+		Size:     size,
+	}, nil
+}
+
+//////
+// Helpers.
+//////
+
+// sniffer keeps the first bytes written to it.
+type sniffer struct {
+	head []byte
+}
+
+// Write implements the io.Writer interface.
+func (s *sniffer) Write(p []byte) (int, error) {
+	if missing := sniffLength - len(s.head); missing > 0 {
+		if len(p) < missing {
+			missing = len(p)
+		}
+
+		s.head = append(s.head, p[:missing]...)
+	}
+
+	return len(p), nil
+}
@@ -0,0 +1,79 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thalesfsp/questionnaire/errorcatalog"
+)
+
+func TestFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+		content  []byte
+		wantMIME string
+	}{
+		{
+			name:     "Should work - detected from the content",
+			fileName: "id.png",
+			content:  append([]byte("\x89PNG\x0D\x0A\x1A\x0A"), make([]byte, 100)...),
+			wantMIME: "image/png",
+		},
+		{
+			name:     "Should work - text",
+			fileName: "notes",
+			content:  []byte("hello"),
+			wantMIME: "text/plain",
+		},
+		{
+			name:     "Should work - unknown content falls back to the extension",
+			fileName: "../../doc.pdf",
+			content:  []byte{0x00, 0x01, 0x02},
+			wantMIME: "application/pdf",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			f, err := NewFile(t.TempDir())
+			assert.NoError(t, err)
+
+			m, err := f.Put(ctx, tt.fileName, bytes.NewReader(tt.content))
+			assert.NoError(t, err)
+
+			sum := sha256.Sum256(tt.content)
+
+			assert.Equal(t, hex.EncodeToString(sum[:]), m.Hash)
+			assert.Equal(t, int64(len(tt.content)), m.Size)
+			assert.Equal(t, tt.wantMIME, m.MIMEType)
+			assert.NotContains(t, m.Name, "/")
+
+			r, err := f.Get(ctx, m.Key)
+			assert.NoError(t, err)
+
+			got, err := io.ReadAll(r)
+			assert.NoError(t, err)
+			assert.NoError(t, r.Close())
+			assert.Equal(t, tt.content, got)
+
+			stat, err := Stat(ctx, f, m.Key, tt.fileName)
+			assert.NoError(t, err)
+			assert.Equal(t, m, stat)
+
+			assert.NoError(t, f.Delete(ctx, m.Key))
+
+			_, err = f.Get(ctx, m.Key)
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrBlobNotFound))
+
+			_, err = f.Get(ctx, "../"+m.Key)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,3 @@
+// Package blob provides the storage of respondent-provided files, e.g.:
+// attachments.
+package blob
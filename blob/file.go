@@ -0,0 +1,149 @@
+package blob
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/thalesfsp/customerror"
+	"github.com/thalesfsp/questionnaire/errorcatalog"
+	"github.com/thalesfsp/questionnaire/internal/shared"
+	"github.com/thalesfsp/validation"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// keyRegex matches valid keys - generated by the store, they can't escape the
+// root directory.
+var keyRegex = regexp.MustCompile(`^[a-zA-Z0-9-]+$`)
+
+// File is a file-system Store. Each blob is a file in the root directory,
+// named after its key:
+//
+//	<dir>/<key>
+type File struct {
+	// Dir is the root directory.
+	Dir string `json:"dir" bson:"dir" validate:"required"`
+}
+
+//////
+// Implements the Store interface.
+//////
+
+// Put stores the content `r` of the file `name`.
+func (f *File) Put(ctx context.Context, name string, r io.Reader) (Metadata, error) {
+	if err := ctx.Err(); err != nil {
+		return Metadata{}, err
+	}
+
+	key := shared.GenerateUUID()
+
+	p := filepath.Join(f.Dir, key)
+
+	file, err := os.OpenFile(p, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return Metadata{}, customerror.NewFailedToError("create blob", customerror.WithError(err))
+	}
+
+	h := sha256.New()
+	s := &sniffer{}
+
+	size, err := io.Copy(io.MultiWriter(file, h, s), r)
+
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		os.Remove(p)
+
+		return Metadata{}, customerror.NewFailedToError("store blob", customerror.WithError(err))
+	}
+
+	return Metadata{
+		Hash:     hex.EncodeToString(h.Sum(nil)),
+		Key:      key,
+		MIMEType: DetectMIMEType(name, s.head),
+		Name:     filepath.Base(name),
+		Size:     size,
+	}, nil
+}
+
+// Get retrieves the content of the blob `key`.
+func (f *File) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	p, err := f.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(p)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, errorcatalog.Catalog.MustGet(errorcatalog.ErrBlobNotFound)
+		}
+
+		return nil, customerror.NewFailedToError("open blob", customerror.WithError(err))
+	}
+
+	return file, nil
+}
+
+// Delete the blob `key`.
+func (f *File) Delete(ctx context.Context, key string) error {
+	p, err := f.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(p); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return errorcatalog.Catalog.MustGet(errorcatalog.ErrBlobNotFound)
+		}
+
+		return customerror.NewFailedToError("delete blob", customerror.WithError(err))
+	}
+
+	return nil
+}
+
+//////
+// Helpers.
+//////
+
+// path returns the path of the blob `key`.
+func (f *File) path(key string) (string, error) {
+	if !keyRegex.MatchString(key) {
+		return "", customerror.NewInvalidError("blob key")
+	}
+
+	return filepath.Join(f.Dir, key), nil
+}
+
+//////
+// Factory.
+//////
+
+// NewFile creates a new file-system Store rooted at `dir`.
+func NewFile(dir string) (*File, error) {
+	f := &File{
+		Dir: dir,
+	}
+
+	if err := validation.Validate(f); err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, customerror.NewFailedToError("create directory", customerror.WithError(err))
+	}
+
+	return f, nil
+}
@@ -0,0 +1,364 @@
+package definition
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/thalesfsp/customerror"
+	"github.com/thalesfsp/questionnaire/errorcatalog"
+	"github.com/thalesfsp/questionnaire/option"
+	"github.com/thalesfsp/questionnaire/question"
+	"github.com/thalesfsp/questionnaire/questionnaire"
+	"github.com/thalesfsp/questionnaire/rule"
+	"github.com/thalesfsp/questionnaire/types"
+	"github.com/thalesfsp/questionnaire/validator"
+	"github.com/thalesfsp/status"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// ValueType is the type of the options' values.
+type ValueType string
+
+const (
+	Bool   ValueType = "bool"
+	Float  ValueType = "float"
+	Int    ValueType = "int"
+	String ValueType = "string"
+)
+
+// Option definition.
+type Option struct {
+	// ID of the option. Defaults to its value.
+	ID string `json:"id,omitempty"`
+
+	// Correct flags the option as a correct answer, e.g.: quizzes.
+	Correct bool `json:"correct,omitempty"`
+
+	// Feedback is shown after the option is chosen.
+	Feedback string `json:"feedback,omitempty"`
+
+	// Label of the option.
+	Label string `json:"label,omitempty"`
+
+	// NextQuestionID is the next question ID.
+	NextQuestionID string `json:"nextQuestionID,omitempty"`
+
+	// Rules are the branching rules of the option.
+	Rules []rule.Rule `json:"rules,omitempty"`
+
+	// State is set when the option is chosen.
+	State status.Status `json:"state,omitempty"`
+
+	// Value of the option, of the question's value type.
+	Value any `json:"value"`
+
+	// Weight of the option. Defaults to 1.
+	Weight *int `json:"weight,omitempty"`
+}
+
+// Question definition.
+type Question struct {
+	// ID of the question.
+	ID string `json:"id"`
+
+	// Label is the question.
+	Label string `json:"label"`
+
+	// Type of the question.
+	Type types.Type `json:"type"`
+
+	// ValueType is the type of the options' values. Inferred if omitted.
+	ValueType ValueType `json:"valueType,omitempty"`
+
+	// Attachment constraints of file answers.
+	Attachment *question.Attachment `json:"attachment,omitempty"`
+
+	// Date constraints of date, date-time, and date range answers.
+	Date *question.Date `json:"date,omitempty"`
+
+	// ImageURL is the URL of the image.
+	ImageURL string `json:"imageURL,omitempty"`
+
+	// Matrix of rows sharing the question's options as columns.
+	Matrix *question.Matrix `json:"matrix,omitempty"`
+
+	// NextQuestionID is the default next question ID.
+	NextQuestionID string `json:"nextQuestionID,omitempty"`
+
+	// Number constraints of numeric answers.
+	Number *question.Number `json:"number,omitempty"`
+
+	// Options of the question. Scale questions generate theirs.
+	Options []Option `json:"options,omitempty"`
+
+	// Ranking constraints of ranking answers.
+	Ranking *question.Ranking `json:"ranking,omitempty"`
+
+	// Required flags the question as required.
+	Required bool `json:"required,omitempty"`
+
+	// Rules are the branching rules of the question.
+	Rules []rule.Rule `json:"rules,omitempty"`
+
+	// Scale of ordinal questions, e.g.: Likert, rating, and NPS.
+	Scale *question.Scale `json:"scale,omitempty"`
+
+	// Selection constraints of multiple-select answers.
+	Selection *question.Selection `json:"selection,omitempty"`
+
+	// State is the default state.
+	State status.Status `json:"state,omitempty"`
+
+	// Text constraints of free-text answers.
+	Text *question.Text `json:"text,omitempty"`
+
+	// Validators are run - by name, against the answer's value.
+	Validators []validator.Ref `json:"validators,omitempty"`
+
+	// Weight of the question. Defaults to 1.
+	Weight *int `json:"weight,omitempty"`
+}
+
+// Definition of a questionnaire. See the package documentation.
+type Definition struct {
+	// Title of the questionnaire.
+	Title string `json:"title"`
+
+	// Questions of the questionnaire, in order.
+	Questions []Question `json:"questions"`
+
+	// Outcomes the session is classified as, once finished.
+	Outcomes []questionnaire.Outcome `json:"outcomes,omitempty"`
+
+	// Pages group questions answered together.
+	Pages []questionnaire.Page `json:"pages,omitempty"`
+
+	// Quiz settings of assessments.
+	Quiz *questionnaire.Quiz `json:"quiz,omitempty"`
+}
+
+//////
+// Methods.
+//////
+
+// Build the questionnaire, validating it - see `questionnaire.Analyze`.
+func (d Definition) Build() (*questionnaire.Questionnaire, error) {
+	questions := make([]question.Question, 0, len(d.Questions))
+
+	for _, qd := range d.Questions {
+		qst, err := qd.build()
+		if err != nil {
+			return nil, customerror.Wrap(
+				errorcatalog.Catalog.MustGet(errorcatalog.ErrDefinitionInvalid),
+				fmt.Errorf("question %s: %w", qd.ID, err),
+			)
+		}
+
+		questions = append(questions, qst)
+	}
+
+	params := []questionnaire.Func{
+		questionnaire.WithOutcome(d.Outcomes...),
+		questionnaire.WithPage(d.Pages...),
+		questionnaire.WithValidation(true),
+	}
+
+	if d.Quiz != nil {
+		params = append(params, questionnaire.WithQuiz(*d.Quiz))
+	}
+
+	return questionnaire.NewWithParams(d.Title, questions, params...)
+}
+
+//////
+// Helpers.
+//////
+
+// params returns the params of the question's metadata.
+func (qd Question) params() []question.Func {
+	params := []question.Func{
+		question.WithImageURL(qd.ImageURL),
+		question.WithNextQuestionID(qd.NextQuestionID),
+		question.WithRequired(qd.Required),
+		question.WithRule(qd.Rules...),
+		question.WithValidator(qd.Validators...),
+	}
+
+	if qd.State != "" {
+		params = append(params, question.WithState(qd.State))
+	}
+
+	if qd.Weight != nil {
+		params = append(params, question.WithWeight(*qd.Weight))
+	}
+
+	if qd.Attachment != nil {
+		params = append(params, question.WithAttachment(*qd.Attachment))
+	}
+
+	if qd.Date != nil {
+		params = append(params, question.WithDate(*qd.Date))
+	}
+
+	if qd.Matrix != nil {
+		params = append(params, question.WithMatrix(*qd.Matrix))
+	}
+
+	if qd.Number != nil {
+		params = append(params, question.WithNumber(*qd.Number))
+	}
+
+	if qd.Ranking != nil {
+		params = append(params, question.WithRanking(*qd.Ranking))
+	}
+
+	if qd.Selection != nil {
+		params = append(params, question.WithSelection(*qd.Selection))
+	}
+
+	if qd.Text != nil {
+		params = append(params, question.WithText(*qd.Text))
+	}
+
+	return params
+}
+
+// build the question, with its options typed by the value type.
+func (qd Question) build() (question.Question, error) {
+	if qd.Scale != nil && qd.Type.IsScale() {
+		if len(qd.Options) > 0 {
+			return question.Question{}, fmt.Errorf("scale questions generate their options")
+		}
+
+		return question.NewScale(qd.ID, qd.Label, qd.Type, *qd.Scale, qd.params()...)
+	}
+
+	vt, err := qd.valueType()
+	if err != nil {
+		return question.Question{}, err
+	}
+
+	switch vt {
+	case Bool:
+		return build(qd, func(v any) (bool, bool) {
+			b, ok := v.(bool)
+
+			return b, ok
+		})
+	case Float:
+		return build(qd, func(v any) (float64, bool) {
+			f, ok := v.(float64)
+
+			return f, ok
+		})
+	case Int:
+		return build(qd, func(v any) (int, bool) {
+			f, ok := v.(float64)
+
+			return int(f), ok && f == math.Trunc(f)
+		})
+	case String:
+		return build(qd, func(v any) (string, bool) {
+			s, ok := v.(string)
+
+			return s, ok
+		})
+	}
+
+	return question.Question{}, fmt.Errorf("value type %q isn't supported", vt)
+}
+
+// valueType returns the declared value type, otherwise infers it from the
+// question's type, and options' values.
+func (qd Question) valueType() (ValueType, error) {
+	switch {
+	case qd.ValueType != "":
+		return qd.ValueType, nil
+	case qd.Type == types.Logical:
+		return Bool, nil
+	case qd.Type.IsScale():
+		return Int, nil
+	case len(qd.Options) == 0:
+		return String, nil
+	}
+
+	inferred := ValueType("")
+
+	for _, od := range qd.Options {
+		var vt ValueType
+
+		switch v := od.Value.(type) {
+		case bool:
+			vt = Bool
+		case float64:
+			vt = Int
+
+			if v != math.Trunc(v) {
+				vt = Float
+			}
+		case string:
+			vt = String
+		default:
+			return "", fmt.Errorf("option %v has an unsupported value", od.Value)
+		}
+
+		switch {
+		case inferred == "", inferred == vt:
+			inferred = vt
+		case (inferred == Int && vt == Float) || (inferred == Float && vt == Int):
+			inferred = Float
+		default:
+			return "", fmt.Errorf("options mix %s, and %s values, set the value type", inferred, vt)
+		}
+	}
+
+	return inferred, nil
+}
+
+// build the question `qd`, with options of type `T`. `convert` converts the
+// options' values, `ok` is false if a value isn't of type `T`.
+func build[T bool | float64 | int | string](qd Question, convert func(any) (T, bool)) (question.Question, error) {
+	opts := make([]option.Option[T], 0, len(qd.Options))
+
+	for _, od := range qd.Options {
+		value, ok := convert(od.Value)
+		if !ok {
+			return question.Question{}, fmt.Errorf("option %v isn't of the %T type", od.Value, value)
+		}
+
+		id := od.ID
+
+		if id == "" {
+			id = fmt.Sprint(value)
+		}
+
+		params := []option.Func{
+			option.WithCorrect(od.Correct),
+			option.WithFeedback(od.Feedback),
+			option.WithID(id),
+			option.WithLabel(od.Label),
+			option.WithNextQuestionID(od.NextQuestionID),
+			option.WithRule(od.Rules...),
+		}
+
+		if od.State != "" {
+			params = append(params, option.WithState(od.State))
+		}
+
+		if od.Weight != nil {
+			params = append(params, option.WithWeight(*od.Weight))
+		}
+
+		opt, err := option.New(value, params...)
+		if err != nil {
+			return question.Question{}, err
+		}
+
+		opts = append(opts, opt)
+	}
+
+	return question.New[T](qd.ID, qd.Label, qd.Type, append(qd.params(), question.WithOption(opts...))...)
+}
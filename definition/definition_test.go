@@ -0,0 +1,168 @@
+package definition
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thalesfsp/questionnaire/errorcatalog"
+	"github.com/thalesfsp/questionnaire/option"
+	"github.com/thalesfsp/questionnaire/question"
+	"github.com/thalesfsp/questionnaire/questionnaire"
+	"github.com/thalesfsp/questionnaire/types"
+	"github.com/thalesfsp/status"
+)
+
+func TestLoadFile(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+	}{
+		{
+			name: "Should work - YAML",
+			path: "testdata/survey.yaml",
+		},
+		{
+			name: "Should work - JSON",
+			path: "testdata/survey.json",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := LoadFile(tt.path)
+			assert.NoError(t, err)
+
+			assert.Equal(t, "Developer survey", q.Title)
+			assert.Equal(t, []string{"experience", "language", "satisfaction"}, q.Questions.Keys())
+			assert.Len(t, q.Outcomes, 2)
+			assert.Len(t, q.Pages, 1)
+
+			experience, _ := q.Questions.Get("experience")
+			assert.True(t, experience.Meta.Required)
+
+			senior, err := question.GetOption[string](experience, "10+")
+			assert.NoError(t, err)
+			assert.Equal(t, 10, senior.GetWeight())
+			assert.Equal(t, "language", senior.NextQuestionID())
+
+			language, _ := q.Questions.Get("language")
+			assert.Len(t, language.Meta.Rules, 1)
+
+			goOpt, err := question.GetOption[string](language, "go")
+			assert.NoError(t, err)
+			assert.True(t, goOpt.IsCorrect())
+			assert.Equal(t, "Nice pick!", goOpt.GetFeedback())
+
+			// Scale questions generate their options.
+			satisfaction, _ := q.Questions.Get("satisfaction")
+			assert.Equal(t, 5, satisfaction.Options.Size())
+			assert.Equal(t, status.Completed, satisfaction.Meta.State)
+
+			// Exported, and loaded back, in both formats.
+			want, err := From(*q)
+			assert.NoError(t, err)
+
+			for _, f := range []Format{JSON, YAML} {
+				var buf bytes.Buffer
+
+				assert.NoError(t, Export(&buf, *q, f))
+
+				loaded, err := Load(&buf, f)
+				assert.NoError(t, err)
+
+				got, err := From(*loaded)
+				assert.NoError(t, err)
+				assert.Equal(t, want, got)
+			}
+		})
+	}
+}
+
+func TestLoad_invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition string
+		wantErr    error
+	}{
+		{
+			name:       "Should fail - unknown field",
+			definition: "title: Survey\nquestions:\n  - id: q\n    label: Q\n    type: text\n    stat: completed\n",
+			wantErr:    errorcatalog.Catalog.MustGet(errorcatalog.ErrDefinitionInvalid),
+		},
+		{
+			name:       "Should fail - mixed values",
+			definition: "title: Survey\nquestions:\n  - id: q\n    label: Q\n    type: single-select\n    options: [{value: 1, state: completed}, {value: one, state: completed}]\n",
+			wantErr:    errorcatalog.Catalog.MustGet(errorcatalog.ErrDefinitionInvalid),
+		},
+		{
+			name:       "Should fail - value of the wrong type",
+			definition: "title: Survey\nquestions:\n  - id: q\n    label: Q\n    type: single-select\n    valueType: int\n    options: [{value: 1.5, state: completed}]\n",
+			wantErr:    errorcatalog.Catalog.MustGet(errorcatalog.ErrDefinitionInvalid),
+		},
+		{
+			name:       "Should fail - scale with options",
+			definition: "title: Survey\nquestions:\n  - id: q\n    label: Q\n    type: likert\n    scale: {min: 1, max: 5}\n    options: [{value: 1}]\n",
+			wantErr:    errorcatalog.Catalog.MustGet(errorcatalog.ErrDefinitionInvalid),
+		},
+		{
+			name:       "Should fail - invalid graph",
+			definition: "title: Survey\nquestions:\n  - id: q\n    label: Q\n    type: text\n    nextQuestionID: nowhere\n",
+			wantErr:    errorcatalog.Catalog.MustGet(errorcatalog.ErrQuestionnaireInvalid),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(strings.NewReader(tt.definition), YAML)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestExportFile(t *testing.T) {
+	yes := option.MustNew(true, option.WithID("yes"), option.WithState(status.Completed))
+	no := option.MustNew(false, option.WithID("no"), option.WithNextQuestionID("price"))
+
+	price := option.MustNew(9.5, option.WithID("9.5"), option.WithState(status.Completed))
+	round := option.MustNew(10.0, option.WithID("10"), option.WithState(status.Completed))
+
+	q, err := questionnaire.NewWithParams("Pricing", []question.Question{
+		question.MustNew[bool]("fair", "Is it fair?", types.Logical, question.WithOption(yes, no)),
+		question.MustNew[float64]("price", "Fair price", types.SingleSelect, question.WithOption(price, round)),
+	})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name string
+		file string
+	}{
+		{
+			name: "Should work - YAML",
+			file: "pricing.yml",
+		},
+		{
+			name: "Should work - JSON",
+			file: "pricing.json",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.file)
+
+			assert.NoError(t, ExportFile(path, *q))
+
+			loaded, err := LoadFile(path)
+			assert.NoError(t, err)
+
+			// Typed values survive, e.g.: float options with integral values.
+			raw, ok := loaded.Questions.Values()[1].Options.Get("10")
+			assert.True(t, ok)
+			assert.IsType(t, option.Option[float64]{}, raw)
+
+			raw, ok = loaded.Questions.Values()[0].Options.Get("yes")
+			assert.True(t, ok)
+			assert.IsType(t, option.Option[bool]{}, raw)
+		})
+	}
+}
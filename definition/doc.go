@@ -0,0 +1,45 @@
+// Package definition loads questionnaires from declarative YAML, or JSON files,
+// and exports them back to the same format, so surveys can be authored without
+// Go code.
+//
+// A definition lists the questions - in order, the first one is where the
+// machine starts, with their options, branching, states, weights, and
+// metadata. Fields are named after their Go counterparts, e.g.:
+//
+//	title: Developer survey
+//	questions:
+//	  - id: experience
+//	    label: Years of experience
+//	    type: single-select
+//	    required: true
+//	    options:
+//	      - value: 0-2
+//	        nextQuestionID: language
+//	      - value: 10+
+//	        weight: 10
+//	        nextQuestionID: language
+//	  - id: language
+//	    label: Favorite language
+//	    type: multiple-select
+//	    selection: {min: 1}
+//	    nextQuestionID: satisfaction
+//	    options:
+//	      - {value: go, label: Go}
+//	      - {value: rust, label: Rust}
+//	    rules:
+//	      - conditions: [{questionID: experience, operator: eq, value: 10+}]
+//	        state: completed
+//	  - id: satisfaction
+//	    label: How satisfied are you?
+//	    type: likert
+//	    scale: {min: 1, max: 5}
+//	    state: completed
+//
+// Options are typed by the question's `valueType` - bool, float, int, or
+// string. If omitted, it's inferred from the question's type, and options'
+// values. Options' IDs default to their value. Weights default to 1. Scale
+// questions generate their options from the scale. Pages, outcomes, and quiz
+// settings are declared at the top level, as `pages`, `outcomes`, and `quiz`.
+//
+// Loaded questionnaires are validated - see `questionnaire.Analyze`.
+package definition
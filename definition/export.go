@@ -0,0 +1,256 @@
+package definition
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/thalesfsp/customerror"
+	"github.com/thalesfsp/questionnaire/option"
+	"github.com/thalesfsp/questionnaire/question"
+	"github.com/thalesfsp/questionnaire/questionnaire"
+	"github.com/thalesfsp/status"
+	"gopkg.in/yaml.v3"
+)
+
+//////
+// Methods.
+//////
+
+// Marshal the definition in the format `f`.
+func (d Definition) Marshal(f Format) ([]byte, error) {
+	b, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	switch f {
+	case JSON:
+		return append(b, '\n'), nil
+	case YAML:
+		// JSON is YAML. Decoding it as a node keeps the fields' order.
+		var node yaml.Node
+
+		if err := yaml.Unmarshal(b, &node); err != nil {
+			return nil, err
+		}
+
+		block(&node)
+
+		var buf bytes.Buffer
+
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(2)
+
+		if err := enc.Encode(&node); err != nil {
+			return nil, err
+		}
+
+		if err := enc.Close(); err != nil {
+			return nil, err
+		}
+
+		return buf.Bytes(), nil
+	}
+
+	return nil, customerror.NewInvalidError("definition format " + f.String())
+}
+
+//////
+// Exported functionalities.
+//////
+
+// From returns the definition of the questionnaire `q`.
+func From(q questionnaire.Questionnaire) (Definition, error) {
+	d := Definition{
+		Outcomes:  q.Outcomes,
+		Pages:     q.Pages,
+		Questions: []Question{},
+		Quiz:      q.Quiz,
+		Title:     q.Title,
+	}
+
+	if q.Questions == nil {
+		return d, nil
+	}
+
+	for _, qst := range q.Questions.Values() {
+		qd, err := from(qst)
+		if err != nil {
+			return Definition{}, err
+		}
+
+		d.Questions = append(d.Questions, qd)
+	}
+
+	return d, nil
+}
+
+// Export writes the definition of the questionnaire `q` to `w`, in the format
+// `f`.
+func Export(w io.Writer, q questionnaire.Questionnaire, f Format) error {
+	d, err := From(q)
+	if err != nil {
+		return err
+	}
+
+	b, err := d.Marshal(f)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(b)
+
+	return err
+}
+
+// ExportFile writes the definition of the questionnaire `q` to the file
+// `path`. The format is determined by its extension.
+func ExportFile(path string, q questionnaire.Questionnaire) error {
+	f, err := FormatOf(path)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := Export(file, q, f); err != nil {
+		file.Close()
+
+		return err
+	}
+
+	return file.Close()
+}
+
+//////
+// Helpers.
+//////
+
+// from returns the definition of the question `qst`. Defaults are omitted.
+func from(qst question.Question) (Question, error) {
+	m := qst.Meta
+
+	qd := Question{
+		Attachment:     m.Attachment,
+		Date:           m.Date,
+		ID:             qst.GetID(),
+		ImageURL:       m.ImageURL,
+		Label:          qst.Label,
+		Matrix:         m.Matrix,
+		NextQuestionID: m.NextQuestionID,
+		Number:         m.Number,
+		Ranking:        m.Ranking,
+		Required:       m.Required,
+		Rules:          m.Rules,
+		Scale:          m.Scale,
+		Selection:      m.Selection,
+		State:          state(m.State),
+		Text:           m.Text,
+		Type:           qst.Type,
+		Validators:     m.Validators,
+		Weight:         weight(m.Weight),
+	}
+
+	// Scale questions generate their options.
+	if (m.Scale != nil && qst.Type.IsScale()) || qst.Options == nil {
+		return qd, nil
+	}
+
+	var vt ValueType
+
+	for _, raw := range qst.Options.Values() {
+		t, err := valueTypeOf(raw)
+		if err != nil {
+			return Question{}, fmt.Errorf("question %s: %w", qst.GetID(), err)
+		}
+
+		vt = t
+
+		opt, err := option.ToOption[any](raw)
+		if err != nil {
+			return Question{}, err
+		}
+
+		od := Option{
+			Correct:        opt.IsCorrect(),
+			Feedback:       opt.GetFeedback(),
+			ID:             opt.GetID(),
+			Label:          opt.GetLabel(),
+			NextQuestionID: opt.NextQuestionID(),
+			Rules:          opt.GetRules(),
+			State:          state(opt.GetState()),
+			Value:          opt.GetValue(),
+			Weight:         weight(opt.GetWeight()),
+		}
+
+		if od.ID == fmt.Sprint(od.Value) {
+			od.ID = ""
+		}
+
+		qd.Options = append(qd.Options, od)
+	}
+
+	// The value type is only declared if it can't be inferred.
+	if inferred, err := qd.valueType(); err != nil || inferred != vt {
+		qd.ValueType = vt
+	}
+
+	return qd, nil
+}
+
+// valueTypeOf returns the value type of the option `raw`. Options loaded from
+// storage (e.g. JSON) are typed by their value.
+func valueTypeOf(raw any) (ValueType, error) {
+	switch raw.(type) {
+	case option.Option[bool]:
+		return Bool, nil
+	case option.Option[float64]:
+		return Float, nil
+	case option.Option[int]:
+		return Int, nil
+	case option.Option[string]:
+		return String, nil
+	}
+
+	value, _ := option.ValueOf(raw)
+
+	vt, err := Question{Options: []Option{{Value: value}}}.valueType()
+	if err != nil {
+		return "", err
+	}
+
+	return vt, nil
+}
+
+// state returns the state `s`, omitting the default one.
+func state(s status.Status) status.Status {
+	if s == status.None {
+		return ""
+	}
+
+	return s
+}
+
+// weight returns the weight `w`, omitting the default one.
+func weight(w int) *int {
+	if w == 1 {
+		return nil
+	}
+
+	return &w
+}
+
+// block sets the YAML `node`, and its children to the block style.
+func block(node *yaml.Node) {
+	node.Style = 0
+
+	for _, child := range node.Content {
+		block(child)
+	}
+}
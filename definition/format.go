@@ -0,0 +1,129 @@
+package definition
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/thalesfsp/customerror"
+	"github.com/thalesfsp/questionnaire/errorcatalog"
+	"github.com/thalesfsp/questionnaire/questionnaire"
+	"gopkg.in/yaml.v3"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// Format of definition files.
+type Format string
+
+const (
+	JSON Format = "json"
+	YAML Format = "yaml"
+)
+
+//////
+// Methods.
+//////
+
+// String implements the Stringer interface.
+func (f Format) String() string {
+	return string(f)
+}
+
+//////
+// Exported functionalities.
+//////
+
+// FormatOf returns the format of the file `path`, by its extension.
+func FormatOf(path string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return JSON, nil
+	case ".yaml", ".yml":
+		return YAML, nil
+	}
+
+	return "", customerror.NewInvalidError("definition format of " + path)
+}
+
+// Parse the definition `data` in the format `f`. Unknown fields are refused,
+// catching typos.
+func Parse(data []byte, f Format) (Definition, error) {
+	switch f {
+	case JSON:
+	case YAML:
+		// YAML is converted to JSON, so both share the same field names.
+		var v any
+
+		if err := yaml.Unmarshal(data, &v); err != nil {
+			return Definition{}, invalid(err)
+		}
+
+		b, err := json.Marshal(v)
+		if err != nil {
+			return Definition{}, invalid(err)
+		}
+
+		data = b
+	default:
+		return Definition{}, customerror.NewInvalidError("definition format " + f.String())
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+
+	var d Definition
+
+	if err := dec.Decode(&d); err != nil {
+		return Definition{}, invalid(err)
+	}
+
+	return d, nil
+}
+
+// Load the questionnaire defined in `r`, in the format `f`.
+func Load(r io.Reader, f Format) (*questionnaire.Questionnaire, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := Parse(data, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.Build()
+}
+
+// LoadFile loads the questionnaire defined in the file `path`. The format is
+// determined by its extension.
+func LoadFile(path string) (*questionnaire.Questionnaire, error) {
+	f, err := FormatOf(path)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	return Load(file, f)
+}
+
+//////
+// Helpers.
+//////
+
+// invalid wraps `err` as an invalid definition.
+func invalid(err error) error {
+	return customerror.Wrap(errorcatalog.Catalog.MustGet(errorcatalog.ErrDefinitionInvalid), err)
+}
@@ -3,14 +3,84 @@ package errorcatalog
 import "github.com/thalesfsp/customerror"
 
 const (
-	ErrAnswerOptionRequired = "ERR_ANSWER_OPTION_REQUIRED"
-	ErrAnswerOptionType     = "ERR_ANSWER_OPTION_TYPE"
-	ErrForwardMissingQors   = "ERR_FORWARD_MISSING_QORS"
+	ErrAnswerAttachmentSize         = "ERR_ANSWER_ATTACHMENT_SIZE"
+	ErrAnswerAttachmentType         = "ERR_ANSWER_ATTACHMENT_TYPE"
+	ErrAnswerDateInvalid            = "ERR_ANSWER_DATE_INVALID"
+	ErrAnswerDateRange              = "ERR_ANSWER_DATE_RANGE"
+	ErrAnswerNumberInvalid          = "ERR_ANSWER_NUMBER_INVALID"
+	ErrAnswerNumberRange            = "ERR_ANSWER_NUMBER_RANGE"
+	ErrAnswerNumberStep             = "ERR_ANSWER_NUMBER_STEP"
+	ErrAnswerOptionRequired         = "ERR_ANSWER_OPTION_REQUIRED"
+	ErrAnswerOptionType             = "ERR_ANSWER_OPTION_TYPE"
+	ErrAnswerRankingDuplicate       = "ERR_ANSWER_RANKING_DUPLICATE"
+	ErrAnswerRankingIncomplete      = "ERR_ANSWER_RANKING_INCOMPLETE"
+	ErrAnswerRowRequired            = "ERR_ANSWER_ROW_REQUIRED"
+	ErrAnswerSelectionCount         = "ERR_ANSWER_SELECTION_COUNT"
+	ErrAnswerTextLength             = "ERR_ANSWER_TEXT_LENGTH"
+	ErrAnswerTextPattern            = "ERR_ANSWER_TEXT_PATTERN"
+	ErrAnswerValidation             = "ERR_ANSWER_VALIDATION"
+	ErrBlobNotFound                 = "ERR_BLOB_NOT_FOUND"
+	ErrDefinitionInvalid            = "ERR_DEFINITION_INVALID"
+	ErrFSMAlreadyFinished           = "ERR_FSM_ALREADY_FINISHED"
+	ErrFSMBlobStoreMissing          = "ERR_FSM_BLOB_STORE_MISSING"
+	ErrFSMInvalidTransition         = "ERR_FSM_INVALID_TRANSITION"
+	ErrFSMNotStarted                = "ERR_FSM_NOT_STARTED"
+	ErrForwardInvalidInput          = "ERR_FORWARD_INVALID_INPUT"
+	ErrForwardInvalidOption         = "ERR_FORWARD_INVALID_OPTION"
+	ErrForwardInvalidPage           = "ERR_FORWARD_INVALID_PAGE"
+	ErrForwardInvalidRow            = "ERR_FORWARD_INVALID_ROW"
+	ErrForwardMissingQors           = "ERR_FORWARD_MISSING_QORS"
+	ErrForwardPaged                 = "ERR_FORWARD_PAGED"
+	ErrJournalQuestionnaireNotFound = "ERR_JOURNAL_QUESTIONNAIRE_NOT_FOUND"
+	ErrQuestionnaireInvalid         = "ERR_QUESTIONNAIRE_INVALID"
+	ErrQuestionnaireInvalidOutcome  = "ERR_QUESTIONNAIRE_INVALID_OUTCOME"
+	ErrQuestionnaireInvalidPage     = "ERR_QUESTIONNAIRE_INVALID_PAGE"
+	ErrReplayInvalidTransition      = "ERR_REPLAY_INVALID_TRANSITION"
+	ErrReplayMismatch               = "ERR_REPLAY_MISMATCH"
+	ErrSchemaMismatch               = "ERR_SCHEMA_MISMATCH"
+	ErrSkipRequired                 = "ERR_SKIP_REQUIRED"
+	ErrStoreSessionNotFound         = "ERR_STORE_SESSION_NOT_FOUND"
+	ErrStoreVersionConflict         = "ERR_STORE_VERSION_CONFLICT"
 )
 
 // Catalog of errors.
 var Catalog = customerror.
 	MustNewCatalog("questionnaire").
+	MustSet(ErrAnswerAttachmentSize, "Answer's attachment is too large").
+	MustSet(ErrAnswerAttachmentType, "Answer's attachment type isn't allowed").
+	MustSet(ErrAnswerDateInvalid, "Answer's date is invalid").
+	MustSet(ErrAnswerDateRange, "Answer's date is out of bounds").
+	MustSet(ErrAnswerNumberInvalid, "Answer's number is invalid").
+	MustSet(ErrAnswerNumberRange, "Answer's number is out of bounds").
+	MustSet(ErrAnswerNumberStep, "Answer's number doesn't match the step").
 	MustSet(ErrAnswerOptionRequired, "Question's answer is required").
 	MustSet(ErrAnswerOptionType, "Answer's option type is invalid").
-	MustSet(ErrForwardMissingQors, "Missing setting the question ID or the state")
+	MustSet(ErrAnswerRankingDuplicate, "Answer's ranking has a duplicated option").
+	MustSet(ErrAnswerRankingIncomplete, "Answer's ranking doesn't cover the options").
+	MustSet(ErrAnswerRowRequired, "Matrix row's answer is required").
+	MustSet(ErrAnswerSelectionCount, "Answer's number of selected options is out of bounds").
+	MustSet(ErrAnswerTextLength, "Answer's text length is out of bounds").
+	MustSet(ErrAnswerTextPattern, "Answer's text doesn't match the pattern").
+	MustSet(ErrAnswerValidation, "Answer failed validation").
+	MustSet(ErrBlobNotFound, "Blob not found").
+	MustSet(ErrDefinitionInvalid, "Definition is invalid").
+	MustSet(ErrFSMAlreadyFinished, "Questionnaire is already finished").
+	MustSet(ErrFSMBlobStoreMissing, "Blob store isn't set").
+	MustSet(ErrFSMInvalidTransition, "State transition isn't allowed").
+	MustSet(ErrFSMNotStarted, "Questionnaire isn't started").
+	MustSet(ErrForwardInvalidInput, "Question doesn't accept this kind of answer").
+	MustSet(ErrForwardInvalidOption, "Option doesn't belong to the current question").
+	MustSet(ErrForwardInvalidPage, "Question doesn't belong to the current page").
+	MustSet(ErrForwardInvalidRow, "Row doesn't belong to the current question").
+	MustSet(ErrForwardMissingQors, "Missing setting the question ID or the state").
+	MustSet(ErrForwardPaged, "Question is part of a page, forward the page").
+	MustSet(ErrJournalQuestionnaireNotFound, "Journal's questionnaire not found").
+	MustSet(ErrQuestionnaireInvalid, "Questionnaire is invalid").
+	MustSet(ErrQuestionnaireInvalidOutcome, "Questionnaire's outcome is invalid").
+	MustSet(ErrQuestionnaireInvalidPage, "Questionnaire's page is invalid").
+	MustSet(ErrReplayInvalidTransition, "Transition doesn't apply to the state of the machine").
+	MustSet(ErrReplayMismatch, "Replayed state doesn't match the recorded one").
+	MustSet(ErrSchemaMismatch, "Document doesn't match the schema").
+	MustSet(ErrSkipRequired, "Required question can't be skipped").
+	MustSet(ErrStoreSessionNotFound, "Session not found").
+	MustSet(ErrStoreVersionConflict, "Session was changed by another writer (stale version)")
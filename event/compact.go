@@ -0,0 +1,281 @@
+package event
+
+import (
+	"fmt"
+
+	"github.com/thalesfsp/customerror"
+	"github.com/thalesfsp/go-common-types/safeorderedmap"
+	"github.com/thalesfsp/params/common"
+	"github.com/thalesfsp/questionnaire/answer"
+	"github.com/thalesfsp/questionnaire/errorcatalog"
+	"github.com/thalesfsp/questionnaire/question"
+	"github.com/thalesfsp/questionnaire/questionnaire"
+	"github.com/thalesfsp/questionnaire/scoring"
+	"github.com/thalesfsp/status"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// Delta is the compact form of an event. It only holds what the transition
+// changed, everything else is derived from the questionnaire, and from the
+// previous deltas.
+type Delta struct {
+	common.Common `bson:",inline"`
+
+	// Answers recorded by the transition. The first delta of a journal holds
+	// all answers recorded so far.
+	Answers []answer.Answer `json:"answers,omitempty" bson:"answers,omitempty"`
+
+	// CurrentQuestionID is the ID of the current question.
+	CurrentQuestionID string `json:"currentQuestionID,omitempty" bson:"currentQuestionID,omitempty"`
+
+	// OutcomeID is the ID of the outcome the session is classified as.
+	OutcomeID string `json:"outcomeID,omitempty" bson:"outcomeID,omitempty"`
+
+	// PreviousQuestionID is the ID of the previous question.
+	PreviousQuestionID string `json:"previousQuestionID,omitempty" bson:"previousQuestionID,omitempty"`
+
+	// PreviousQuestionIDs are the previous question IDs of the questions,
+	// indexed by question ID, as set so far. The machine sets them while
+	// answering, the stored questionnaire is as it was.
+	PreviousQuestionIDs map[string]string `json:"previousQuestionIDs,omitempty" bson:"previousQuestionIDs,omitempty"`
+
+	// QuestionnaireHash is the hash of the questionnaire.
+	QuestionnaireHash string `json:"questionnaireHash" bson:"questionnaireHash"`
+
+	// QuestionnaireID is the ID of the questionnaire.
+	QuestionnaireID string `json:"questionnaireID" bson:"questionnaireID"`
+
+	// Score is the running score of the session.
+	Score scoring.Score `json:"score" bson:"score"`
+
+	// State is the current state of the questionnaire.
+	State status.Status `json:"state" bson:"state"`
+
+	// Transition is the command which produced the event.
+	Transition Transition `json:"transition" bson:"transition"`
+
+	// UserID is the ID of the user.
+	UserID string `json:"userID" bson:"userID"`
+
+	// Version of the machine's state.
+	Version int64 `json:"version" bson:"version"`
+}
+
+// CompactJournal is a journal which stores each questionnaire once - by hash,
+// and per-event deltas only. Its size grows linearly with the number of
+// events, while a journal of full events grows quadratically.
+type CompactJournal struct {
+	// Deltas is the list of deltas, in order.
+	Deltas []Delta `json:"deltas" bson:"deltas"`
+
+	// Questionnaires indexed by hash.
+	Questionnaires map[string]questionnaire.Questionnaire `json:"questionnaires" bson:"questionnaires"`
+}
+
+//////
+// Methods.
+//////
+
+// Add compacts the event, and adds it to the journal.
+func (j *CompactJournal) Add(e Event) {
+	if j.Questionnaires == nil {
+		j.Questionnaires = map[string]questionnaire.Questionnaire{}
+	}
+
+	if _, ok := j.Questionnaires[e.Questionnaire.Hash]; !ok {
+		j.Questionnaires[e.Questionnaire.Hash] = e.Questionnaire.Clone()
+	}
+
+	j.Deltas = append(j.Deltas, Compact(e, len(j.Deltas) == 0))
+}
+
+// Size returns the number of events in the journal.
+func (j *CompactJournal) Size() int {
+	return len(j.Deltas)
+}
+
+// Materialize expands the deltas back into full events.
+func (j *CompactJournal) Materialize() ([]Event, error) {
+	events := make([]Event, 0, len(j.Deltas))
+
+	answers := safeorderedmap.New[answer.Answer]()
+
+	for _, d := range j.Deltas {
+		q, ok := j.Questionnaires[d.QuestionnaireHash]
+		if !ok {
+			return nil, customerror.Wrap(
+				errorcatalog.Catalog.MustGet(errorcatalog.ErrJournalQuestionnaireNotFound),
+				fmt.Errorf("hash %q", d.QuestionnaireHash),
+			)
+		}
+
+		for _, aswr := range d.Answers {
+			answers.Add(aswr.GetID(), aswr)
+		}
+
+		events = append(events, d.Materialize(q, answers.Clone()))
+	}
+
+	return events, nil
+}
+
+// Materialize expands the delta into a full event, given the questionnaire,
+// and all answers recorded up to - including - the delta.
+//
+// NOTE: Questions are resolved from the questionnaire, with the previous
+// question IDs of the delta.
+func (d Delta) Materialize(
+	q questionnaire.Questionnaire,
+	answers *safeorderedmap.SafeOrderedMap[answer.Answer],
+) Event {
+	q = chain(q, d.PreviousQuestionIDs)
+
+	var (
+		currentQst, previousQst question.Question
+		cQI, totalQuestions     int
+		pageQsts                []question.Question
+	)
+
+	if q.Questions != nil {
+		currentQst, _ = q.Questions.Get(d.CurrentQuestionID)
+		previousQst, _ = q.Questions.Get(d.PreviousQuestionID)
+		cQI, _, _ = q.Questions.Index(d.CurrentQuestionID)
+		totalQuestions = q.Questions.Size()
+	}
+
+	page, paged := q.PageOf(d.CurrentQuestionID)
+	if paged {
+		pageQsts = q.PageQuestions(page)
+	}
+
+	currentAswr, _ := answers.Get(d.CurrentQuestionID)
+
+	var outcome *questionnaire.Outcome
+
+	if o, ok := q.Outcome(d.OutcomeID); ok {
+		outcome = &o
+	}
+
+	return Event{
+		Common:               d.Common,
+		PreviousQuestion:     previousQst,
+		CurrentQuestion:      currentQst,
+		CurrentQuestionIndex: cQI,
+		CurrentPage:          page,
+		CurrentPageQuestions: pageQsts,
+		CurrentAnswer:        currentAswr,
+		Outcome:              outcome,
+		Score:                d.Score,
+		State:                d.State,
+		TotalAnswers:         answers.Size(),
+		TotalQuestions:       totalQuestions,
+		Transition:           d.Transition,
+		Answers:              answers,
+		Questionnaire:        q,
+		QuestionnaireID:      d.QuestionnaireID,
+		UserID:               d.UserID,
+		Version:              d.Version,
+	}
+}
+
+//////
+// Helpers.
+//////
+
+// chain returns the questionnaire `q` with the previous question IDs `ids` -
+// a copy, if they differ from its ones.
+func chain(q questionnaire.Questionnaire, ids map[string]string) questionnaire.Questionnaire {
+	if q.Questions == nil {
+		return q
+	}
+
+	differs := false
+
+	q.Questions.Each(func(id string, qst question.Question) {
+		differs = differs || qst.PreviousQuestionID != ids[id]
+	})
+
+	if !differs {
+		return q
+	}
+
+	q = q.Clone()
+
+	for _, id := range q.Questions.Keys() {
+		qst, _ := q.Questions.Get(id)
+		qst.PreviousQuestionID = ids[id]
+
+		q.Questions.Add(id, qst)
+	}
+
+	return q
+}
+
+//////
+// Factory.
+//////
+
+// Compact the event into a delta. Only answering, and skipping a question
+// record answers, so only the answer of the transition's question - or the
+// page's ones, is kept, unless `baseline` is set - then all answers are kept,
+// e.g.: first delta of a journal, which may start from a loaded machine. The
+// previous question IDs set so far are always kept.
+func Compact(e Event, baseline bool) Delta {
+	d := Delta{
+		Common:             e.Common,
+		CurrentQuestionID:  e.CurrentQuestion.GetID(),
+		PreviousQuestionID: e.PreviousQuestion.GetID(),
+		QuestionnaireHash:  e.Questionnaire.Hash,
+		QuestionnaireID:    e.QuestionnaireID,
+		Score:              e.Score,
+		State:              e.State,
+		Transition:         e.Transition,
+		UserID:             e.UserID,
+		Version:            e.Version,
+	}
+
+	if e.Outcome != nil {
+		d.OutcomeID = e.Outcome.ID
+	}
+
+	if e.Questionnaire.Questions != nil {
+		e.Questionnaire.Questions.Each(func(id string, qst question.Question) {
+			if qst.PreviousQuestionID == "" {
+				return
+			}
+
+			if d.PreviousQuestionIDs == nil {
+				d.PreviousQuestionIDs = map[string]string{}
+			}
+
+			d.PreviousQuestionIDs[id] = qst.PreviousQuestionID
+		})
+	}
+
+	if e.Answers == nil {
+		return d
+	}
+
+	switch {
+	case baseline:
+		d.Answers = e.Answers.Values()
+	case e.Transition.Type == TransitionForwarded || e.Transition.Type == TransitionSkipped:
+		// Pages record the answers of all of its questions.
+		responses := e.Transition.Responses
+
+		if len(responses) == 0 {
+			responses = []Transition{e.Transition}
+		}
+
+		for _, r := range responses {
+			if aswr, ok := e.Answers.Get(r.QuestionID); ok {
+				d.Answers = append(d.Answers, aswr)
+			}
+		}
+	}
+
+	return d
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/thalesfsp/questionnaire/answer"
 	"github.com/thalesfsp/questionnaire/question"
 	"github.com/thalesfsp/questionnaire/questionnaire"
+	"github.com/thalesfsp/questionnaire/scoring"
 	"github.com/thalesfsp/status"
 )
 
@@ -30,9 +31,23 @@ type Event struct {
 	// CurrentQuestionIndex is the current question index.
 	CurrentQuestionIndex int `json:"currentQuestionIndex" bson:"currentQuestionIndex"`
 
+	// CurrentPage is the page of the current question, if it's part of one.
+	CurrentPage questionnaire.Page `json:"currentPage" bson:"currentPage"`
+
+	// CurrentPageQuestions are the questions of the current page, in order.
+	CurrentPageQuestions []question.Question `json:"currentPageQuestions,omitempty" bson:"currentPageQuestions,omitempty"`
+
 	// CurrentAnswer is the current answer.
 	CurrentAnswer answer.Answer `json:"currentAnswer" bson:"currentAnswer"`
 
+	// Outcome the session is classified as, once finished. Nil if the
+	// questionnaire has no outcomes, or none matches. See
+	// `questionnaire.Outcome`.
+	Outcome *questionnaire.Outcome `json:"outcome,omitempty" bson:"outcome,omitempty"`
+
+	// Score is the running score of the session.
+	Score scoring.Score `json:"score" bson:"score"`
+
 	// State is the current state of the questionnaire.
 	State status.Status `json:"state" bson:"state"`
 
@@ -42,6 +57,9 @@ type Event struct {
 	// TotalQuestions is the total number of questions.
 	TotalQuestions int `json:"totalQuestions" bson:"totalQuestions"`
 
+	// Transition is the command which produced the event.
+	Transition Transition `json:"transition" bson:"transition"`
+
 	//////
 	// Data.
 	//////
@@ -52,6 +70,47 @@ type Event struct {
 	// Questionnaire is the questionnaire.
 	Questionnaire questionnaire.Questionnaire `json:"questionnaire" bson:"questionnaire" validate:"-"`
 
+	// QuestionnaireID is the ID of the questionnaire.
+	QuestionnaireID string `json:"questionnaireID" bson:"questionnaireID"`
+
 	// UserID is the ID of the user.
 	UserID string `json:"userID" bson:"userID"`
+
+	// Version of the machine's state. It increases monotonically with every
+	// event, so stores can reject stale writes.
+	Version int64 `json:"version" bson:"version"`
+}
+
+//////
+// Methods.
+//////
+
+// Feedback returns the feedback of the options chosen by the event's
+// transition - all responses of a page included, keyed by question ID. It's
+// empty if none of them has feedback.
+func (e Event) Feedback() map[string][]string {
+	feedback := map[string][]string{}
+
+	if e.Answers == nil {
+		return feedback
+	}
+
+	responses := e.Transition.Responses
+
+	if len(responses) == 0 {
+		responses = []Transition{e.Transition}
+	}
+
+	for _, r := range responses {
+		aswr, ok := e.Answers.Get(r.QuestionID)
+		if !ok {
+			continue
+		}
+
+		if f := aswr.Feedback(); len(f) > 0 {
+			feedback[r.QuestionID] = f
+		}
+	}
+
+	return feedback
 }
@@ -0,0 +1,90 @@
+package event
+
+import "github.com/thalesfsp/questionnaire/blob"
+
+//////
+// Consts, vars, and types.
+//////
+
+// TransitionType is the type of the state machine transition which produced
+// an event.
+type TransitionType string
+
+const (
+	// TransitionBackward is going back to the previous question.
+	TransitionBackward TransitionType = "backward"
+
+	// TransitionDone is finishing the questionnaire.
+	TransitionDone TransitionType = "done"
+
+	// TransitionDumped is dumping the state of the machine. It doesn't change
+	// the state of the machine.
+	TransitionDumped TransitionType = "dumped"
+
+	// TransitionEmitted is emitting the state of the machine, on demand. It
+	// doesn't change the state of the machine.
+	TransitionEmitted TransitionType = "emitted"
+
+	// TransitionForwarded is answering the current question.
+	TransitionForwarded TransitionType = "forwarded"
+
+	// TransitionJumped is jumping to an answered question.
+	TransitionJumped TransitionType = "jumped"
+
+	// TransitionSkipped is skipping the current question - or a question of
+	// the submitted page (see `Transition.Responses`).
+	TransitionSkipped TransitionType = "skipped"
+
+	// TransitionStarted is starting the state machine.
+	TransitionStarted TransitionType = "started"
+)
+
+// Transition is the command which produced an event. Replaying the
+// transitions of a journal - in order - reproduces the state of the machine.
+type Transition struct {
+	// Type of the transition.
+	Type TransitionType `json:"type" bson:"type"`
+
+	// QuestionID is the ID of the question answered, skipped, or jumped to.
+	QuestionID string `json:"questionID,omitempty" bson:"questionID,omitempty"`
+
+	// Attachment is the metadata of the respondent-provided file. Its content
+	// is in the blob store.
+	Attachment *blob.Metadata `json:"attachment,omitempty" bson:"attachment,omitempty"`
+
+	// OptionID is the ID of the chosen option.
+	OptionID string `json:"optionID,omitempty" bson:"optionID,omitempty"`
+
+	// PageID is the ID of the submitted page.
+	PageID string `json:"pageID,omitempty" bson:"pageID,omitempty"`
+
+	// Responses are the answered, or skipped questions of the submitted page,
+	// in order.
+	Responses []Transition `json:"responses,omitempty" bson:"responses,omitempty"`
+
+	// OptionIDs are the IDs of the selected options, e.g.: multiple-select -
+	// or the ranked ones, in order.
+	OptionIDs []string `json:"optionIDs,omitempty" bson:"optionIDs,omitempty"`
+
+	// RowOptionIDs are the IDs of the selected options per row ID, e.g.:
+	// matrix.
+	RowOptionIDs map[string][]string `json:"rowOptionIDs,omitempty" bson:"rowOptionIDs,omitempty"`
+
+	// Value is the respondent-provided value, e.g.: free text.
+	Value string `json:"value,omitempty" bson:"value,omitempty"`
+}
+
+//////
+// Methods.
+//////
+
+// String implements the Stringer interface.
+func (t TransitionType) String() string {
+	return string(t)
+}
+
+// ChangesState returns true if the transition changes the state of the
+// machine, otherwise it's just a snapshot.
+func (t Transition) ChangesState() bool {
+	return t.Type != TransitionDumped && t.Type != TransitionEmitted
+}
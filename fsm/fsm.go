@@ -4,9 +4,18 @@ import (
 	"context"
 	"expvar"
 	"fmt"
-
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thalesfsp/customerror"
 	"github.com/thalesfsp/go-common-types/safeorderedmap"
+	"github.com/thalesfsp/params/common"
 	"github.com/thalesfsp/questionnaire/answer"
+	"github.com/thalesfsp/questionnaire/blob"
 	"github.com/thalesfsp/questionnaire/errorcatalog"
 	"github.com/thalesfsp/questionnaire/event"
 	"github.com/thalesfsp/questionnaire/internal/customapm"
@@ -16,6 +25,10 @@ import (
 	"github.com/thalesfsp/questionnaire/option"
 	"github.com/thalesfsp/questionnaire/question"
 	"github.com/thalesfsp/questionnaire/questionnaire"
+	"github.com/thalesfsp/questionnaire/rule"
+	"github.com/thalesfsp/questionnaire/scoring"
+	"github.com/thalesfsp/questionnaire/store"
+	"github.com/thalesfsp/questionnaire/types"
 	"github.com/thalesfsp/status"
 	"github.com/thalesfsp/sypl"
 	"github.com/thalesfsp/sypl/level"
@@ -36,10 +49,50 @@ const (
 
 // Callback is a function that is called every time the state of the
 // questionnaire changes.
+//
+// NOTE: It's called while the machine is locked, don't call the machine's
+// methods from it - use the event, and the journal. In the compact journal
+// mode, the journal is materialized on every call - prefer `CompactCallback`.
 type Callback func(e event.Event, journal []event.Event)
 
-// FiniteStateMachine is the Finite State Machine for the Questionnaire.
+// CompactCallback is a function that is called every time the state of the
+// questionnaire changes, if the journal mode is compact. The journal is as
+// kept by the machine, don't change it.
+//
+// NOTE: It's called while the machine is locked, don't call the machine's
+// methods from it - use the event, and the journal.
+type CompactCallback func(e event.Event, journal *event.CompactJournal)
+
+// transitions is the table of allowed state transitions: from a state, to
+// the states. Finished states (e.g.: done) don't transition. Running sessions
+// can be done before all questions are answered, e.g.: a screening option
+// ending the session, or the respondent quitting. See `CanTransition`.
+var transitions = map[status.Status][]status.Status{
+	status.Initialized: {status.Runnning},
+	status.Runnning:    {status.Runnning, status.Completed, status.Done, status.Failed, status.Succeeded},
+	status.Completed:   {status.Runnning, status.Completed, status.Done, status.Failed, status.Succeeded},
+	status.Done:        {},
+	status.Failed:      {},
+	status.Succeeded:   {},
+}
+
+// JournalMode is how the machine keeps its journal.
+type JournalMode string
+
+const (
+	// JournalCompact keeps the questionnaire once, and per-event deltas only.
+	JournalCompact JournalMode = "compact"
+
+	// JournalFull keeps full events. It's the default.
+	JournalFull JournalMode = "full"
+)
+
+// FiniteStateMachine is the Finite State Machine for the Questionnaire. It's
+// safe for concurrent use: transitions are serialized per machine.
 type FiniteStateMachine struct {
+	// mu serializes transitions.
+	mu sync.Mutex
+
 	// CurrentQuestion is the current question.
 	CurrentQuestion question.Question `json:"currentQuestion" bson:"currentQuestion"`
 
@@ -82,20 +135,72 @@ type FiniteStateMachine struct {
 	// UserID is the ID of the user.
 	UserID string `json:"userID" validate:"required" bson:"userID"`
 
+	// Version is the version of the machine's state. It's incremented every
+	// time an event is emitted.
+	Version int64 `json:"version" bson:"version"`
+
 	// Callback is the function that is called every time the state of the
 	// questionnaire changes. For example: save the state to the database.
 	callback Callback `json:"-" bson:"-"`
 
+	// compactCallback is called, instead of materializing the journal for
+	// the callback, if the journal mode is compact.
+	compactCallback CompactCallback `json:"-" bson:"-"`
+
+	// store persists the machine every time its state changes.
+	store store.Store `json:"-" bson:"-"`
+
+	// blobStore stores the respondent-provided files, e.g.: attachments.
+	blobStore blob.Store `json:"-" bson:"-"`
+
+	// scoring computes the running score. Defaults to `scoring.Sum`, or
+	// `scoring.Quiz` for quizzes.
+	scoring scoring.Strategy `json:"-" bson:"-"`
+
+	// compactJournal is the journal, if the journal mode is compact.
+	compactJournal *event.CompactJournal `json:"-" bson:"-"`
+
 	// Metrics.
 	counterBackward            *expvar.Int `json:"-" bson:"-" validate:"required,gte=0"`
+	counterBackwardFailed      *expvar.Int `json:"-" bson:"-" validate:"required,gte=0"`
 	counterCompleted           *expvar.Int `json:"-" bson:"-" validate:"required,gte=0"`
 	counterDone                *expvar.Int `json:"-" bson:"-" validate:"required,gte=0"`
+	counterDoneFailed          *expvar.Int `json:"-" bson:"-" validate:"required,gte=0"`
 	counterEmitted             *expvar.Int `json:"-" bson:"-" validate:"required,gte=0"`
 	counterForward             *expvar.Int `json:"-" bson:"-" validate:"required,gte=0"`
 	counterForwardFailed       *expvar.Int `json:"-" bson:"-" validate:"required,gte=0"`
 	counterInitialized         *expvar.Int `json:"-" bson:"-" validate:"required,gte=0"`
+	counterInitializedFailed   *expvar.Int `json:"-" bson:"-" validate:"required,gte=0"`
 	counterInstantiationFailed *expvar.Int `json:"-" bson:"-" validate:"required,gte=0"`
 	counterJump                *expvar.Int `json:"-" bson:"-" validate:"required,gte=0"`
+	counterJumpFailed          *expvar.Int `json:"-" bson:"-" validate:"required,gte=0"`
+	counterPersistFailed       *expvar.Int `json:"-" bson:"-" validate:"required,gte=0"`
+	counterReplayFailed        *expvar.Int `json:"-" bson:"-" validate:"required,gte=0"`
+	counterSkip                *expvar.Int `json:"-" bson:"-" validate:"required,gte=0"`
+	counterSkipFailed          *expvar.Int `json:"-" bson:"-" validate:"required,gte=0"`
+}
+
+// route is where the machine goes after answering a question. Rules take
+// precedence over the static next question ID, and state.
+type route struct {
+	nextQuestionID string
+	rules          []rule.Rule
+	state          status.Status
+}
+
+// memento is the state of the machine changed by transitions. It's restored
+// if the transition can't be persisted.
+type memento struct {
+	answers              *safeorderedmap.SafeOrderedMap[answer.Answer]
+	currentAnswer        answer.Answer
+	currentQuestion      question.Question
+	currentQuestionID    string
+	currentQuestionIndex int
+	previousQuestion     question.Question
+	previousQuestionID   string
+	questions            *safeorderedmap.SafeOrderedMap[question.Question]
+	state                status.Status
+	version              int64
 }
 
 //////
@@ -109,16 +214,35 @@ func (fsm *FiniteStateMachine) GetLogger() sypl.ISypl {
 
 // AddToJournal adds an entry to the journal.
 func (fsm *FiniteStateMachine) AddToJournal(e event.Event) {
-	fsm.Journal = append(fsm.Journal, e)
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
+
+	fsm.addToJournal(e)
 }
 
-// GetJournal returns the journal.
+// GetJournal returns the journal. If the journal mode is compact, it's
+// materialized into full events.
 func (fsm *FiniteStateMachine) GetJournal() []event.Event {
-	return fsm.Journal
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
+
+	return fsm.journal()
+}
+
+// GetCompactJournal returns the journal, if the journal mode is compact,
+// otherwise nil.
+func (fsm *FiniteStateMachine) GetCompactJournal() *event.CompactJournal {
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
+
+	return fsm.compactJournal
 }
 
 // GetState returns the current state of the questionnaire.
 func (fsm *FiniteStateMachine) GetState() status.Status {
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
+
 	return fsm.State
 }
 
@@ -126,13 +250,162 @@ func (fsm *FiniteStateMachine) GetState() status.Status {
 // Helpers.
 //////
 
+// addToJournal adds an entry to the journal.
+func (fsm *FiniteStateMachine) addToJournal(e event.Event) {
+	if fsm.compactJournal != nil {
+		fsm.compactJournal.Add(e)
+
+		return
+	}
+
+	fsm.Journal = append(fsm.Journal, e)
+}
+
+// journal returns the journal. If the journal mode is compact, it's
+// materialized into full events.
+func (fsm *FiniteStateMachine) journal() []event.Event {
+	if fsm.compactJournal != nil {
+		journal, err := fsm.compactJournal.Materialize()
+		if err != nil {
+			fsm.GetLogger().Errorln(err)
+		}
+
+		return journal
+	}
+
+	return fsm.Journal
+}
+
+// CanTransition returns true if the transition from the state `from` to the
+// state `to` is allowed.
+func CanTransition(from, to status.Status) bool {
+	for _, s := range transitions[from] {
+		if s == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// transition checks if the machine can make the transition `t` to the state
+// `to`, returning an error describing why not. Starting is the only
+// transition from the initialized state, and only from it.
+func (fsm *FiniteStateMachine) transition(t event.TransitionType, to status.Status) error {
+	starting := t == event.TransitionStarted
+
+	if starting == (fsm.State == status.Initialized) && CanTransition(fsm.State, to) {
+		return nil
+	}
+
+	code := errorcatalog.ErrFSMInvalidTransition
+
+	switch {
+	case fsm.State == status.Initialized:
+		code = errorcatalog.ErrFSMNotStarted
+	case isFinished(fsm.State):
+		code = errorcatalog.ErrFSMAlreadyFinished
+	}
+
+	return customerror.Wrap(
+		errorcatalog.Catalog.MustGet(code),
+		fmt.Errorf("from %q to %q", fsm.State, to),
+	)
+}
+
+// isState returns true if `s` is set.
+func isState(s status.Status) bool {
+	return s != "" && s != status.None
+}
+
+// isFinished returns true if the state `s` is a finished one - no transitions
+// from it.
+func isFinished(s status.Status) bool {
+	to, ok := transitions[s]
+
+	return ok && len(to) == 0
+}
+
+// snapshot returns the state of the machine, restored if the transition
+// being made can't be persisted. Without a store, nothing rejects it - nil.
+func (fsm *FiniteStateMachine) snapshot() *memento {
+	if fsm.store == nil {
+		return nil
+	}
+
+	m := &memento{
+		currentAnswer:        fsm.CurrentAnswer,
+		currentQuestion:      fsm.CurrentQuestion,
+		currentQuestionID:    fsm.CurrentQuestionID,
+		currentQuestionIndex: fsm.CurrentQuestionIndex,
+		previousQuestion:     fsm.PreviousQuestion,
+		previousQuestionID:   fsm.PreviousQuestionID,
+		questions:            fsm.Questionnaire.Clone().Questions,
+		state:                fsm.State,
+		version:              fsm.Version,
+	}
+
+	if fsm.Answers != nil {
+		m.answers = fsm.Answers.Clone()
+	}
+
+	return m
+}
+
+// restore the state of the machine `m`, if any.
+func (fsm *FiniteStateMachine) restore(m *memento) {
+	if m == nil {
+		return
+	}
+
+	fsm.Answers = m.answers
+	fsm.CurrentAnswer = m.currentAnswer
+	fsm.CurrentQuestion = m.currentQuestion
+	fsm.CurrentQuestionID = m.currentQuestionID
+	fsm.CurrentQuestionIndex = m.currentQuestionIndex
+	fsm.PreviousQuestion = m.previousQuestion
+	fsm.PreviousQuestionID = m.previousQuestionID
+	fsm.Questionnaire.Questions = m.questions
+	fsm.State = m.state
+	fsm.Version = m.version
+}
+
+// persist saves the snapshot, and appends the event to the journal, if a
+// store is set. The store rejects stale snapshots, e.g.: another machine of
+// the same session moved on - then nothing is appended.
+//
+// NOTE: If appending fails, the snapshot is already saved. Resume the machine.
+func (fsm *FiniteStateMachine) persist(ctx context.Context, e event.Event) error {
+	if fsm.store == nil {
+		return nil
+	}
+
+	if err := fsm.store.Save(ctx, e); err != nil {
+		return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterPersistFailed)
+	}
+
+	if err := fsm.store.Append(ctx, e); err != nil {
+		return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterPersistFailed)
+	}
+
+	return nil
+}
+
 // Navigate goes back to the previous question, or jump to the specified one.
-func (fsm *FiniteStateMachine) navigate(id string) *FiniteStateMachine {
-	// Should do nothing if there's no previous question or if the FSM is in the
-	// Started status.
-	// if fsm.PreviousQuestionID == "" || fsm.State == status.Initialized {
-	// 	return fsm
-	// }
+func (fsm *FiniteStateMachine) navigate(id string) error {
+	t := event.Transition{Type: event.TransitionBackward}
+	counter, counterFailed := fsm.counterBackward, fsm.counterBackwardFailed
+
+	if id != "" {
+		t = event.Transition{Type: event.TransitionJumped, QuestionID: id}
+		counter, counterFailed = fsm.counterJump, fsm.counterJumpFailed
+	}
+
+	if err := fsm.transition(t.Type, status.Runnning); err != nil {
+		return customapm.TraceError(context.Background(), err, fsm.GetLogger(), counterFailed)
+	}
+
+	m := fsm.snapshot()
 
 	// Load the current question - just to store reference.
 	currentQst, _ := fsm.Questionnaire.Questions.Get(fsm.CurrentQuestionID)
@@ -147,7 +420,7 @@ func (fsm *FiniteStateMachine) navigate(id string) *FiniteStateMachine {
 
 	aswr, ok := fsm.Answers.Get(finalID)
 	if !ok { // Should do nothing if there's no answer.
-		return fsm
+		return nil
 	}
 
 	// Load the answered question.
@@ -179,16 +452,174 @@ func (fsm *FiniteStateMachine) navigate(id string) *FiniteStateMachine {
 	fsm.State = status.Runnning
 
 	// Emit the state of the machine.
-	fsm.Emit(currentQst, answeredQst)
+	_, err := fsm.emit(context.Background(), m, t, currentQst, answeredQst)
+
+	// Observability: metrics.
+	counter.Add(1)
+
+	return err
+}
+
+// lookup returns a rule.Lookup based on the answers given so far, plus the
+// `pending` ones which aren't recorded yet.
+func (fsm *FiniteStateMachine) lookup(pending ...answer.Answer) rule.Lookup {
+	get := func(questionID string) (answer.Answer, bool) {
+		for _, aswr := range pending {
+			if questionID == aswr.GetID() {
+				return aswr, true
+			}
+		}
 
-	// Metrics: increment the counter.
-	if id == "" {
-		fsm.counterBackward.Add(1)
-	} else {
-		fsm.counterJump.Add(1)
+		return fsm.Answers.Get(questionID)
 	}
 
-	return fsm
+	return func(questionID string) (any, bool) {
+		if aswr, ok := get(questionID); ok {
+			return aswr.Value(), true
+		}
+
+		// Rows of matrices are referenced as "<question ID>.<row ID>".
+		if id, row, found := strings.Cut(questionID, question.RowSeparator); found {
+			if aswr, ok := get(id); ok {
+				return aswr.RowValue(row)
+			}
+		}
+
+		return nil, false
+	}
+}
+
+// advance records the answers of the `steps` - in order, and moves the machine
+// to the next question, and/or to the specified state by the route of the last
+// step. Without both, there's nowhere to go. Branching rules - the route's
+// ones, and then the question's ones take precedence. `from` is the question -
+// or the first question of the page, being left. `t` is the transition being
+// made.
+func (fsm *FiniteStateMachine) advance(
+	ctx context.Context,
+	from question.Question,
+	steps []step,
+	t event.Transition,
+) error {
+	last := steps[len(steps)-1]
+
+	nextQstID := last.route.nextQuestionID
+	state := last.route.state
+
+	answers := make([]answer.Answer, 0, len(steps))
+
+	for _, s := range steps {
+		answers = append(answers, s.aswr)
+	}
+
+	// Rules are evaluated against the answers, including the current ones.
+	allRules := make([]rule.Rule, 0, len(last.route.rules)+len(last.qst.Meta.Rules))
+	allRules = append(allRules, last.route.rules...)
+	allRules = append(allRules, last.qst.Meta.Rules...)
+
+	if matched, ok := rule.Evaluate(fsm.lookup(answers...), allRules...); ok {
+		nextQstID = matched.NextQuestionID
+		state = matched.State
+	}
+
+	// Questions loaded from storage may not have the state set.
+	if state == "" {
+		state = status.None
+	}
+
+	if nextQstID == "" && state == status.None {
+		return errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardMissingQors)
+	}
+
+	// Options, and rules can only set states the machine can transition to.
+	if state != status.None && !CanTransition(status.Runnning, state) {
+		return customerror.Wrap(
+			errorcatalog.Catalog.MustGet(errorcatalog.ErrFSMInvalidTransition),
+			fmt.Errorf("from %q to %q", status.Runnning, state),
+		)
+	}
+
+	m := fsm.snapshot()
+
+	// The answers are valid, and routed. Ensure's the proper state is set.
+	fsm.State = status.Runnning
+
+	// Sets the previous question ID to the current question ID.
+	fsm.PreviousQuestionID = from.GetID()
+
+	// Add answers to the list.
+	for _, aswr := range answers {
+		fsm.Answers.Add(aswr.GetID(), aswr)
+	}
+
+	// If all questions have been answered - skipped ones included, transition
+	// to the completed status.
+	if fsm.Answers.Size() == fsm.Questionnaire.Questions.Size() {
+		fsm.State = status.Completed
+	}
+
+	//////
+	// Deal with the next question.
+	//////
+
+	if nextQstID == "" {
+		fsm.State = state
+
+		// Load previous question.
+		var prevQst question.Question
+
+		if from.PreviousQuestionID != "" {
+			prevQst, _ = fsm.Questionnaire.Questions.Get(from.PreviousQuestionID)
+		}
+
+		fsm.grade()
+
+		// Emit the state of the machine.
+		_, err := fsm.emit(ctx, m, t, prevQst, from)
+
+		return err
+	}
+
+	// Loads the question from the Questionnaire.
+	nextQst, _ := fsm.Questionnaire.Questions.Get(nextQstID)
+
+	//////
+	// Deal with setting the previous question.
+	//////
+
+	// Sets the previous question ID.
+	if nextQst.PreviousQuestionID == "" {
+		nextQst.PreviousQuestionID = from.GetID()
+	}
+
+	// Update questionnaire's questions with the updated one persisting the
+	// change.
+	fsm.Questionnaire.Questions.Add(nextQst.GetID(), nextQst)
+
+	//////
+	// Deal with settings the current question ID, and determining the status.
+	//////
+
+	// Update the current question ID.
+	fsm.CurrentQuestionID = nextQst.GetID()
+
+	// Set the current question.
+	fsm.CurrentQuestion = nextQst
+
+	// Set the current question index.
+	fsm.CurrentQuestionIndex = fsm.CurrentQuestion.GetIndex()
+
+	// Optionally, set the state based on the option (answer).
+	if state != status.None {
+		fsm.State = state
+	}
+
+	fsm.grade()
+
+	// Emit the state of the machine.
+	_, err := fsm.emit(ctx, m, t, from, nextQst)
+
+	return err
 }
 
 //////
@@ -196,7 +627,16 @@ func (fsm *FiniteStateMachine) navigate(id string) *FiniteStateMachine {
 //////
 
 // Start the state machine.
-func (fsm *FiniteStateMachine) Start() *FiniteStateMachine {
+func (fsm *FiniteStateMachine) Start() error {
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
+
+	if err := fsm.transition(event.TransitionStarted, status.Runnning); err != nil {
+		return customapm.TraceError(context.Background(), err, fsm.GetLogger(), fsm.counterInitializedFailed)
+	}
+
+	m := fsm.snapshot()
+
 	// Transition to the answering status.
 	fsm.State = status.Runnning
 
@@ -213,66 +653,142 @@ func (fsm *FiniteStateMachine) Start() *FiniteStateMachine {
 	fsm.CurrentQuestionIndex = fsm.CurrentQuestion.GetIndex()
 
 	// Emit the state of the machine.
-	fsm.Emit(question.Question{}, qst)
+	_, err := fsm.emit(context.Background(), m, event.Transition{Type: event.TransitionStarted}, question.Question{}, qst)
 
 	// Observability: metrics.
 	fsm.counterInitialized.Add(1)
 
-	return fsm
+	return err
 }
 
 // Backward goes back to the previous question.
-func (fsm *FiniteStateMachine) Backward() *FiniteStateMachine {
+func (fsm *FiniteStateMachine) Backward() error {
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
+
 	return fsm.navigate("")
 }
 
 // Jump to the specified question.
-func (fsm *FiniteStateMachine) Jump(id string) *FiniteStateMachine {
+func (fsm *FiniteStateMachine) Jump(id string) error {
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
+
 	return fsm.navigate(id)
 }
 
-// Done the FSM setting the state to `Done`.
-func (fsm *FiniteStateMachine) Done() *FiniteStateMachine {
+// Done the FSM setting the state to `Done`. Quizzes are graded instead, see
+// `grade`.
+func (fsm *FiniteStateMachine) Done() error {
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
+
+	if err := fsm.transition(event.TransitionDone, status.Done); err != nil {
+		return customapm.TraceError(context.Background(), err, fsm.GetLogger(), fsm.counterDoneFailed)
+	}
+
+	m := fsm.snapshot()
+
 	// Ensure's the proper state is set.
 	fsm.State = status.Done
 
+	fsm.grade()
+
 	// Emit the state of the machine.
-	fsm.Dump()
+	_, err := fsm.dump(context.Background(), m, event.Transition{Type: event.TransitionDone})
 
 	// Observability: metrics.
 	fsm.counterDone.Add(1)
 
-	return fsm
+	return err
 }
 
 // Emit emits the state of the machine, also returning it. Do whatever you want
 // with it :) (e.g. persist it). It includes the a dump of the journal, so you
 // can use it to restore the state of the machine.
 func (fsm *FiniteStateMachine) Emit(prevQst, currentQst question.Question) event.Event {
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
+
+	e, _ := fsm.emit(context.Background(), fsm.snapshot(), event.Transition{Type: event.TransitionEmitted}, prevQst, currentQst)
+
+	return e
+}
+
+// emit emits the state of the machine produced by the transition `t`. Errors
+// are persistence ones: the transition is rejected, and the machine restored
+// to the state before it (`m`) - nothing is emitted.
+func (fsm *FiniteStateMachine) emit(
+	ctx context.Context,
+	m *memento,
+	t event.Transition,
+	prevQst, currentQst question.Question,
+) (event.Event, error) {
 	aswr, _ := fsm.Answers.Get(currentQst.GetID())
 
 	cQI, _, _ := fsm.Questionnaire.Questions.Index(currentQst.GetID())
 
+	// Questions of a page are displayed, and answered together.
+	var pageQsts []question.Question
+
+	page, paged := fsm.Questionnaire.PageOf(currentQst.GetID())
+	if paged {
+		pageQsts = fsm.Questionnaire.PageQuestions(page)
+	}
+
+	score := fsm.strategy()(fsm.Questionnaire, fsm.Answers.Values())
+
+	now := time.Now()
+
+	fsm.Version++
+
+	// Events are snapshots - the machine keeps changing its answers, and
+	// questions, e.g.: previous question IDs.
 	e := event.Event{
+		Common: common.Common{
+			ID:        shared.GenerateUUID(),
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+
 		CurrentQuestion:      currentQst,
 		CurrentQuestionIndex: cQI,
+		CurrentPage:          page,
+		CurrentPageQuestions: pageQsts,
 		CurrentAnswer:        aswr,
+		Outcome:              fsm.classify(score),
+		Score:                score,
 		State:                fsm.State,
 		TotalAnswers:         fsm.Answers.Size(),
 		TotalQuestions:       fsm.Questionnaire.Questions.Size(),
+		Transition:           t,
 		UserID:               fsm.UserID,
+		Version:              fsm.Version,
 		PreviousQuestion:     prevQst,
-		Answers:              fsm.Answers,
-		Questionnaire:        fsm.Questionnaire,
+		Answers:              fsm.Answers.Clone(),
+		Questionnaire:        fsm.Questionnaire.Clone(),
+		QuestionnaireID:      fsm.Questionnaire.ID,
+	}
+
+	// Persist the state of the machine first, if a store is set. Observers
+	// don't see rejected transitions.
+	if err := fsm.persist(ctx, e); err != nil {
+		fsm.restore(m)
+
+		return e, err
 	}
 
 	// Emit the state of the machine.
 	if fsm.callback != nil {
-		fsm.callback(e, fsm.GetJournal())
+		fsm.callback(e, fsm.journal())
+	}
+
+	if fsm.compactCallback != nil && fsm.compactJournal != nil {
+		fsm.compactCallback(e, fsm.compactJournal)
 	}
 
 	// Add the entry to the journal.
-	fsm.AddToJournal(e)
+	fsm.addToJournal(e)
 
 	// Observability: metrics.
 	fsm.counterEmitted.Add(1)
@@ -280,30 +796,181 @@ func (fsm *FiniteStateMachine) Emit(prevQst, currentQst question.Question) event
 	// Observability: log.
 	fsm.GetLogger().Debuglnf("%+v", e)
 
-	return e
+	return e, nil
 }
 
 // Dump returns the current state of the machine.
 func (fsm *FiniteStateMachine) Dump() event.Event {
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
+
+	e, _ := fsm.dump(context.Background(), fsm.snapshot(), event.Transition{Type: event.TransitionDumped})
+
+	return e
+}
+
+// dump emits the current state of the machine produced by the transition `t`.
+// `m` is the state before the transition, see `emit`.
+func (fsm *FiniteStateMachine) dump(ctx context.Context, m *memento, t event.Transition) (event.Event, error) {
 	// Make sure to emit the latest state
 	previousQuestion, _ := fsm.Questionnaire.Questions.Get(fsm.PreviousQuestionID)
 	currentQuestion, _ := fsm.Questionnaire.Questions.Get(fsm.CurrentQuestionID)
 
-	return fsm.Emit(previousQuestion, currentQuestion)
+	return fsm.emit(ctx, m, t, previousQuestion, currentQuestion)
 }
 
 // SetCallback sets the callback to be called when the state of the machine
 // changes.
 func (fsm *FiniteStateMachine) SetCallback(cb Callback) {
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
+
 	fsm.callback = cb
 }
 
-// Forward the current question with the given option.
+// SetCompactCallback sets the callback to be called when the state of the
+// machine changes, if the journal mode is compact.
+func (fsm *FiniteStateMachine) SetCompactCallback(cb CompactCallback) {
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
+
+	fsm.compactCallback = cb
+}
+
+// SetJournalMode sets how the machine keeps its journal. Entries already in
+// the journal are kept, converted to the new mode.
+//
+// NOTE: Set it before starting the machine.
+func (fsm *FiniteStateMachine) SetJournalMode(m JournalMode) {
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
+
+	journal := fsm.journal()
+
+	fsm.Journal = nil
+	fsm.compactJournal = nil
+
+	if m == JournalCompact {
+		fsm.compactJournal = &event.CompactJournal{}
+	}
+
+	for _, e := range journal {
+		fsm.addToJournal(e)
+	}
+}
+
+// SetScoring sets the strategy computing the running score of the session,
+// e.g.: `scoring.PHQ9`.
+func (fsm *FiniteStateMachine) SetScoring(s scoring.Strategy) {
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
+
+	fsm.scoring = s
+}
+
+// strategy returns the scoring strategy of the machine.
+func (fsm *FiniteStateMachine) strategy() scoring.Strategy {
+	switch {
+	case fsm.scoring != nil:
+		return fsm.scoring
+	case fsm.Questionnaire.Quiz != nil:
+		return scoring.Quiz
+	default:
+		return scoring.Sum
+	}
+}
+
+// classify returns the outcome the session is classified as, once completed,
+// or finished - nil otherwise, or if none matches. Outcome conditions compare
+// the answers, and the `score`.
+func (fsm *FiniteStateMachine) classify(score scoring.Score) *questionnaire.Outcome {
+	if fsm.State != status.Completed && !isFinished(fsm.State) {
+		return nil
+	}
+
+	answers := fsm.lookup()
+
+	o, ok := fsm.Questionnaire.Classify(func(id string) (any, bool) {
+		if questionnaire.IsScoreRef(id) {
+			return score.Value(id)
+		}
+
+		return answers(id)
+	})
+	if !ok {
+		return nil
+	}
+
+	return &o
+}
+
+// grade determines the final state of quizzes: once completed, or done, the
+// session succeeds if it passes, otherwise it fails. Graded regardless of the
+// scoring strategy.
 //
-//nolint:nestif
+// NOTE: Failed, and succeeded are finished states - graded sessions can't be
+// revised, nor done.
+func (fsm *FiniteStateMachine) grade() {
+	if fsm.Questionnaire.Quiz == nil || (fsm.State != status.Completed && fsm.State != status.Done) {
+		return
+	}
+
+	fsm.State = status.Failed
+
+	if scoring.Quiz(fsm.Questionnaire, fsm.Answers.Values()).Band == scoring.Passed {
+		fsm.State = status.Succeeded
+	}
+}
+
+// SetBlobStore sets the store where the respondent-provided files are stored,
+// e.g.: attachments.
+func (fsm *FiniteStateMachine) SetBlobStore(s blob.Store) {
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
+
+	fsm.blobStore = s
+}
+
+// GetAttachment retrieves the file answering the question `questionID`, and
+// its metadata. The caller must close it.
+func (fsm *FiniteStateMachine) GetAttachment(ctx context.Context, questionID string) (io.ReadCloser, blob.Metadata, error) {
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
+
+	if fsm.blobStore == nil {
+		return nil, blob.Metadata{}, errorcatalog.Catalog.MustGet(errorcatalog.ErrFSMBlobStoreMissing)
+	}
+
+	aswr, ok := fsm.Answers.Get(questionID)
+	if !ok || aswr.Attachment == nil {
+		return nil, blob.Metadata{}, customerror.NewNotFoundError("attachment of question " + questionID)
+	}
+
+	r, err := fsm.blobStore.Get(ctx, aswr.Attachment.Key)
+	if err != nil {
+		return nil, blob.Metadata{}, err
+	}
+
+	return r, *aswr.Attachment, nil
+}
+
+// SetStore sets the store where the machine is persisted every time its state
+// changes.
+func (fsm *FiniteStateMachine) SetStore(s store.Store) {
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
+
+	fsm.store = s
+}
+
+// Forward the current question with the given option.
 func Forward[T shared.N](ctx context.Context, fsm *FiniteStateMachine, opt option.Option[T]) error {
-	// Ensure's the proper state is set.
-	fsm.State = status.Runnning
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
+
+	if err := fsm.transition(event.TransitionForwarded, status.Runnning); err != nil {
+		return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterForwardFailed)
+	}
 
 	//////
 	// Determine the current question.
@@ -312,95 +979,378 @@ func Forward[T shared.N](ctx context.Context, fsm *FiniteStateMachine, opt optio
 	// Retrieves the current question from the Questionnaire.
 	qst, _ := fsm.Questionnaire.Questions.Get(fsm.CurrentQuestionID)
 
-	// Sets the previous question ID to the current question ID.
-	fsm.PreviousQuestionID = qst.GetID()
-
 	//////
-	// Deal with answer.
-	//
-	// NOTE: The following are all the possible types of answers. Update
-	// accordingly.
+	// Deal with the option.
 	//////
 
-	// Create the answer.
-	aswr, err := answer.New(qst, opt)
+	// The option must be one of the current question's options, with the
+	// same value, and type. Anything else is considered tampering.
+	definedOpt, err := question.GetOption[T](qst, opt.GetID())
+	if err != nil || !reflect.DeepEqual(definedOpt.GetValue(), opt.GetValue()) {
+		return customapm.TraceError(
+			ctx,
+			errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardInvalidOption),
+			fsm.GetLogger(),
+			fsm.counterForwardFailed,
+		)
+	}
+
+	// From now on, only the questionnaire's definition of the option is
+	// trusted, e.g.: branching.
+	s, err := stepOption(qst, definedOpt.GetID(), definedOpt, route{
+		nextQuestionID: definedOpt.NextQuestionID(),
+		rules:          definedOpt.GetRules(),
+		state:          definedOpt.GetState(),
+	})
 	if err != nil {
-		return err
+		return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterForwardFailed)
 	}
 
-	// Add answer to the list.
-	fsm.Answers.Add(aswr.GetID(), aswr)
+	return record(ctx, fsm, s)
+}
 
-	// If all questions have been answered, transition to the completed status.
-	if fsm.Answers.Size() == fsm.Questionnaire.Questions.Size() {
-		fsm.State = status.Completed
+// ForwardMany answers the current question selecting the options
+// `optionIDs`. The question must be of the multiple-select type. Its selection
+// constraints - if any, are applied. See `question.Selection` about branching.
+func ForwardMany(ctx context.Context, fsm *FiniteStateMachine, optionIDs ...string) error {
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
+
+	if err := fsm.transition(event.TransitionForwarded, status.Runnning); err != nil {
+		return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterForwardFailed)
 	}
 
-	//////
-	// Deal with the next question.
-	//////
+	// Retrieves the current question from the Questionnaire.
+	qst, _ := fsm.Questionnaire.Questions.Get(fsm.CurrentQuestionID)
 
-	// Get the next question ID.
-	nextQstID := opt.NextQuestionID()
+	s, err := stepMany(qst, optionIDs)
+	if err != nil {
+		return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterForwardFailed)
+	}
 
-	if nextQstID != "" {
-		// Loads the question from the Questionnaire.
-		nextQst, _ := fsm.Questionnaire.Questions.Get(nextQstID)
+	return record(ctx, fsm, s)
+}
 
-		//////
-		// Deal with setting the previous question.
-		//////
+// ForwardMatrix answers the current question selecting the options - columns -
+// per row ID (`rows`). The question must be of the matrix type. Its matrix
+// constraints are applied, e.g.: required rows. The machine follows the
+// question's default next question, or state - and its rules.
+func ForwardMatrix(ctx context.Context, fsm *FiniteStateMachine, rows map[string][]string) error {
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
 
-		// Sets the previous question ID.
-		if nextQst.PreviousQuestionID == "" {
-			nextQst.PreviousQuestionID = qst.GetID()
-		}
+	if err := fsm.transition(event.TransitionForwarded, status.Runnning); err != nil {
+		return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterForwardFailed)
+	}
+
+	// Retrieves the current question from the Questionnaire.
+	qst, _ := fsm.Questionnaire.Questions.Get(fsm.CurrentQuestionID)
+
+	s, err := stepMatrix(qst, rows)
+	if err != nil {
+		return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterForwardFailed)
+	}
+
+	return record(ctx, fsm, s)
+}
+
+// ForwardRanking answers the current question ranking the options
+// `optionIDs`, in order, from the first position. The question must be of the
+// ranking type. Its ranking constraints are applied, e.g.: all - or the top N
+// options, without duplicates. The machine follows the question's default next
+// question, or state - and its rules.
+func ForwardRanking(ctx context.Context, fsm *FiniteStateMachine, optionIDs ...string) error {
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
+
+	if err := fsm.transition(event.TransitionForwarded, status.Runnning); err != nil {
+		return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterForwardFailed)
+	}
+
+	// Retrieves the current question from the Questionnaire.
+	qst, _ := fsm.Questionnaire.Questions.Get(fsm.CurrentQuestionID)
+
+	s, err := stepRanking(qst, optionIDs)
+	if err != nil {
+		return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterForwardFailed)
+	}
+
+	return record(ctx, fsm, s)
+}
+
+// ForwardText answers the current question with the respondent-provided free
+// `text`. The question must be of the text type. Its text constraints - if
+// any, are applied. The machine follows the question's default next question,
+// or state - and its rules.
+func ForwardText(ctx context.Context, fsm *FiniteStateMachine, text string) error {
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
+
+	if err := fsm.transition(event.TransitionForwarded, status.Runnning); err != nil {
+		return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterForwardFailed)
+	}
+
+	// Retrieves the current question from the Questionnaire.
+	qst, _ := fsm.Questionnaire.Questions.Get(fsm.CurrentQuestionID)
+
+	return forwardInput(ctx, fsm, qst, text)
+}
+
+// ForwardNumber answers the current question with the respondent-provided
+// number `n`. The question must be of the integer, or decimal type. Its number
+// constraints - if any, are applied. The machine follows the question's
+// default next question, or state - and its rules, e.g.: numeric ranges.
+func ForwardNumber(ctx context.Context, fsm *FiniteStateMachine, n float64) error {
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
+
+	if err := fsm.transition(event.TransitionForwarded, status.Runnning); err != nil {
+		return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterForwardFailed)
+	}
+
+	// Retrieves the current question from the Questionnaire.
+	qst, _ := fsm.Questionnaire.Questions.Get(fsm.CurrentQuestionID)
 
-		// Update questionnaire's questions with the updated one persisting the
-		// change.
-		fsm.Questionnaire.Questions.Add(nextQst.GetID(), nextQst)
+	return forwardInput(ctx, fsm, qst, strconv.FormatFloat(n, 'f', -1, 64))
+}
+
+// ForwardDate answers the current question with the respondent-provided
+// `dates`: the date, or date-time - or the start, and the end of a date range.
+// The question must be of the date, date-time, or date range type. Its date
+// constraints - if any, are applied. The machine follows the question's default
+// next question, or state - and its rules.
+func ForwardDate(ctx context.Context, fsm *FiniteStateMachine, dates ...time.Time) error {
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
+
+	if err := fsm.transition(event.TransitionForwarded, status.Runnning); err != nil {
+		return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterForwardFailed)
+	}
+
+	// Retrieves the current question from the Questionnaire.
+	qst, _ := fsm.Questionnaire.Questions.Get(fsm.CurrentQuestionID)
+
+	return forwardInput(ctx, fsm, qst, question.FormatDate(qst.Type, dates...))
+}
+
+// forwardInput answers the question `qst` with the respondent-provided
+// `value`.
+func forwardInput(ctx context.Context, fsm *FiniteStateMachine, qst question.Question, value string) error {
+	s, err := stepInput(qst, value)
+	if err != nil {
+		return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterForwardFailed)
+	}
+
+	return record(ctx, fsm, s)
+}
+
+// ForwardAttachment answers the current question with the respondent-provided
+// file `name`, and its content `r`. The question must be of the attachment
+// type, and the blob store set. The file is stored, and its metadata recorded.
+// Its attachment constraints - if any, are applied - files not accepted are
+// deleted. The machine follows the question's default next question, or
+// state - and its rules.
+func ForwardAttachment(ctx context.Context, fsm *FiniteStateMachine, name string, r io.Reader) error {
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
+
+	if err := fsm.transition(event.TransitionForwarded, status.Runnning); err != nil {
+		return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterForwardFailed)
+	}
+
+	// Retrieves the current question from the Questionnaire.
+	qst, _ := fsm.Questionnaire.Questions.Get(fsm.CurrentQuestionID)
+
+	if qst.Type != types.Attachment {
+		return customapm.TraceError(
+			ctx,
+			customerror.Wrap(
+				errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardInvalidInput),
+				fmt.Errorf("question %s is of the %s type", qst.GetID(), qst.Type),
+			),
+			fsm.GetLogger(),
+			fsm.counterForwardFailed,
+		)
+	}
+
+	if fsm.blobStore == nil {
+		return customapm.TraceError(
+			ctx,
+			errorcatalog.Catalog.MustGet(errorcatalog.ErrFSMBlobStoreMissing),
+			fsm.GetLogger(),
+			fsm.counterForwardFailed,
+		)
+	}
+
+	// Files larger than allowed aren't stored entirely.
+	if qst.Meta.Attachment != nil && qst.Meta.Attachment.MaxSize > 0 {
+		r = io.LimitReader(r, qst.Meta.Attachment.MaxSize+1)
+	}
+
+	m, err := fsm.blobStore.Put(ctx, name, r)
+	if err != nil {
+		return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterForwardFailed)
+	}
+
+	// Re-answering, e.g.: after going backward, replaces the file.
+	previous, _ := fsm.Answers.Get(qst.GetID())
+
+	s, err := stepAttachment(qst, m)
+	if err == nil {
+		err = record(ctx, fsm, s)
+	}
+
+	if err != nil {
+		// Best effort, the answer isn't recorded anyway.
+		_ = fsm.blobStore.Delete(ctx, m.Key)
+
+		return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterForwardFailed)
+	}
+
+	// Best effort, the new answer is recorded anyway.
+	if previous.Attachment != nil && previous.Attachment.Key != m.Key {
+		_ = fsm.blobStore.Delete(ctx, previous.Attachment.Key)
+	}
+
+	return nil
+}
 
-		//////
-		// Deal with settings the current question ID, and determining the status.
-		//////
+// ForwardPage answers the questions of the current page with the `responses`:
+// one per question, by ID - e.g.: `OptionID`, `OptionIDs`, or `Value`, as
+// recorded by single-question transitions. Skipped questions - and the ones
+// without a response, are recorded as skipped. Answers are validated as a
+// unit: nothing is recorded if any is invalid. The machine follows the routing
+// of the page's last question.
+//
+// NOTE: Files of attachment questions must be already stored in the machine's
+// blob store (see `SetBlobStore`), responses carry their key, and name. The
+// rest of their metadata is read from the store.
+func ForwardPage(ctx context.Context, fsm *FiniteStateMachine, responses ...event.Transition) error {
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
+
+	if err := fsm.transition(event.TransitionForwarded, status.Runnning); err != nil {
+		return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterForwardFailed)
+	}
+
+	responses, err := fsm.stat(ctx, responses)
+	if err != nil {
+		return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterForwardFailed)
+	}
+
+	return fsm.submit(ctx, responses)
+}
 
-		// Update the current question ID.
-		fsm.CurrentQuestionID = nextQst.GetID()
+// stat returns the `responses` with the metadata of their files read from the
+// blob store - the respondent-provided one isn't trusted.
+func (fsm *FiniteStateMachine) stat(ctx context.Context, responses []event.Transition) ([]event.Transition, error) {
+	stated := make([]event.Transition, 0, len(responses))
 
-		// Set the current question.
-		fsm.CurrentQuestion = nextQst
+	for _, r := range responses {
+		if r.Attachment != nil {
+			if fsm.blobStore == nil {
+				return nil, errorcatalog.Catalog.MustGet(errorcatalog.ErrFSMBlobStoreMissing)
+			}
 
-		// Set the current question index.
-		fsm.CurrentQuestionIndex = fsm.CurrentQuestion.GetIndex()
+			m, err := blob.Stat(ctx, fsm.blobStore, r.Attachment.Key, r.Attachment.Name)
+			if err != nil {
+				return nil, err
+			}
 
-		// Optionally, set the state based on the option (answer).
-		if opt.GetState() != status.None {
-			fsm.State = opt.GetState()
+			r.Attachment = &m
 		}
 
-		// Emit the state of the machine.
-		fsm.Emit(qst, nextQst)
-	} else {
-		if opt.GetState() == status.None {
+		stated = append(stated, r)
+	}
+
+	return stated, nil
+}
+
+// submit answers the page of the current question with the `responses`.
+func (fsm *FiniteStateMachine) submit(ctx context.Context, responses []event.Transition) error {
+	p, ok := fsm.Questionnaire.PageOf(fsm.CurrentQuestionID)
+	if !ok {
+		return customapm.TraceError(
+			ctx,
+			customerror.Wrap(
+				errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardInvalidPage),
+				fmt.Errorf("question %s isn't part of a page", fsm.CurrentQuestionID),
+			),
+			fsm.GetLogger(),
+			fsm.counterForwardFailed,
+		)
+	}
+
+	// Responses must be to distinct questions of the page.
+	byID := make(map[string]event.Transition, len(responses))
+
+	for _, r := range responses {
+		if _, ok := byID[r.QuestionID]; ok || !p.Contains(r.QuestionID) {
 			return customapm.TraceError(
 				ctx,
-				errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardMissingQors),
+				customerror.Wrap(
+					errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardInvalidPage),
+					fmt.Errorf("question %q, page %s", r.QuestionID, p.ID),
+				),
 				fsm.GetLogger(),
 				fsm.counterForwardFailed,
 			)
 		}
 
-		fsm.State = opt.GetState()
+		byID[r.QuestionID] = r
+	}
 
-		// Load previous question.
-		var prevQst question.Question
+	qsts := fsm.Questionnaire.PageQuestions(p)
 
-		if qst.PreviousQuestionID != "" {
-			prevQst, _ = fsm.Questionnaire.Questions.Get(qst.PreviousQuestionID)
+	steps := make([]step, 0, len(qsts))
+	recorded := make([]event.Transition, 0, len(qsts))
+
+	for _, qst := range qsts {
+		r, ok := byID[qst.GetID()]
+		if !ok {
+			r = event.Transition{Type: event.TransitionSkipped, QuestionID: qst.GetID()}
 		}
 
-		// Emit the state of the machine.
-		fsm.Emit(prevQst, qst)
+		s, err := respond(qst, r)
+		if err != nil {
+			return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterForwardFailed)
+		}
+
+		if err := s.aswr.Validate(); err != nil {
+			return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterForwardFailed)
+		}
+
+		steps = append(steps, s)
+		recorded = append(recorded, s.transition)
+	}
+
+	if err := fsm.advance(ctx, qsts[0], steps, event.Transition{
+		Type:      event.TransitionForwarded,
+		PageID:    p.ID,
+		Responses: recorded,
+	}); err != nil {
+		return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterForwardFailed)
+	}
+
+	// Observability: metrics.
+	fsm.counterForward.Add(1)
+
+	return nil
+}
+
+// record validates the answer of the step `s`, and advances the machine
+// through its route, recording its transition.
+func record(ctx context.Context, fsm *FiniteStateMachine, s step) error {
+	if err := fsm.unpaged(s.qst); err != nil {
+		return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterForwardFailed)
+	}
+
+	if err := s.aswr.Validate(); err != nil {
+		return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterForwardFailed)
+	}
+
+	if err := fsm.advance(ctx, s.qst, []step{s}, s.transition); err != nil {
+		return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterForwardFailed)
 	}
 
 	// Observability: metrics.
@@ -409,8 +1359,70 @@ func Forward[T shared.N](ctx context.Context, fsm *FiniteStateMachine, opt optio
 	return nil
 }
 
+// unpaged checks the question `qst` can be answered on its own - it isn't part
+// of a page.
+func (fsm *FiniteStateMachine) unpaged(qst question.Question) error {
+	if p, ok := fsm.Questionnaire.PageOf(qst.GetID()); ok {
+		return customerror.Wrap(
+			errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardPaged),
+			fmt.Errorf("question %s, page %s", qst.GetID(), p.ID),
+		)
+	}
+
+	return nil
+}
+
+// Skip the current question recording an explicit "skipped" answer. Required
+// questions can't be skipped. The machine follows the question's default next
+// question, or state.
+func Skip(ctx context.Context, fsm *FiniteStateMachine) error {
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
+
+	if err := fsm.transition(event.TransitionSkipped, status.Runnning); err != nil {
+		return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterSkipFailed)
+	}
+
+	// Retrieves the current question from the Questionnaire.
+	qst, ok := fsm.Questionnaire.Questions.Get(fsm.CurrentQuestionID)
+	if !ok {
+		return customapm.TraceError(
+			ctx,
+			customerror.NewNotFoundError("question "+fsm.CurrentQuestionID),
+			fsm.GetLogger(),
+			fsm.counterSkipFailed,
+		)
+	}
+
+	if err := fsm.unpaged(qst); err != nil {
+		return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterSkipFailed)
+	}
+
+	s, err := stepSkipped(qst)
+	if err != nil {
+		return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterSkipFailed)
+	}
+
+	if err := fsm.advance(ctx, qst, []step{s}, s.transition); err != nil {
+		return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterSkipFailed)
+	}
+
+	// Observability: metrics.
+	fsm.counterSkip.Add(1)
+
+	return nil
+}
+
 // Load the FSM up to state of the event.
+//
+// NOTE: If the event's questionnaire is the same (same hash) as the one the
+// machine was created with, the machine's one is kept. It's the source of
+// truth, the event's one is a snapshot for audit purposes - only the previous
+// question IDs, set while answering, are taken from it.
 func Load(ctx context.Context, fsm *FiniteStateMachine, e event.Event) *FiniteStateMachine {
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
+
 	fsm.Answers = e.Answers
 	fsm.CurrentAnswer = e.CurrentAnswer
 	fsm.CurrentQuestion = e.CurrentQuestion
@@ -418,15 +1430,215 @@ func Load(ctx context.Context, fsm *FiniteStateMachine, e event.Event) *FiniteSt
 	fsm.CurrentQuestionIndex = e.CurrentQuestionIndex
 	fsm.PreviousQuestion = e.PreviousQuestion
 	fsm.PreviousQuestionID = e.PreviousQuestion.GetID()
-	fsm.Questionnaire = e.Questionnaire
+
+	switch {
+	case fsm.Questionnaire.Hash == "" || fsm.Questionnaire.Hash != e.Questionnaire.Hash:
+		fsm.Questionnaire = e.Questionnaire
+	case e.Questionnaire.Questions != nil:
+		// Going backward follows the session's previous question IDs.
+		e.Questionnaire.Questions.Each(func(id string, qst question.Question) {
+			if current, ok := fsm.Questionnaire.Questions.Get(id); ok {
+				current.PreviousQuestionID = qst.PreviousQuestionID
+
+				fsm.Questionnaire.Questions.Add(id, current)
+			}
+		})
+	}
+
 	fsm.State = e.State
 	fsm.TotalAnswers = e.TotalAnswers
 	fsm.TotalQuestions = e.TotalQuestions
 	fsm.UserID = e.UserID
+	fsm.Version = e.Version
 
 	return fsm
 }
 
+// Resume rebuilds the machine of the user answering the questionnaire from
+// the store. The store is attached to the machine.
+func Resume(ctx context.Context, s store.Store, userID, questionnaireID string) (*FiniteStateMachine, error) {
+	e, err := s.Load(ctx, userID, questionnaireID)
+	if err != nil {
+		return nil, err
+	}
+
+	journal, err := s.Journal(ctx, userID, questionnaireID)
+	if err != nil {
+		return nil, err
+	}
+
+	fsm, err := New(ctx, userID, e.Questionnaire, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	fsm = Load(ctx, fsm, e)
+
+	fsm.Journal = journal
+
+	fsm.SetStore(s)
+
+	return fsm, nil
+}
+
+// Replay rebuilds the machine purely from the transitions recorded in its
+// journal (`events`), applying them - in order - to the questionnaire. Events
+// which don't change the state of the machine (e.g.: dumps) are ignored. The
+// replayed final state must be equal to the recorded one, otherwise it errors.
+//
+// NOTE: The replayed machine has no callback, stores, nor scoring strategy.
+// Set them if needed.
+func Replay(ctx context.Context, q questionnaire.Questionnaire, events []event.Event) (*FiniteStateMachine, error) {
+	userID := ""
+
+	if len(events) > 0 {
+		userID = events[0].UserID
+	}
+
+	fsm, err := New(ctx, userID, q, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range events {
+		if err := fsm.apply(ctx, e.Transition); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(events) > 0 {
+		last := events[len(events)-1]
+
+		if err := fsm.matches(last); err != nil {
+			return nil, customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterReplayFailed)
+		}
+
+		// Continues where the recorded machine stopped.
+		fsm.Version = last.Version
+	}
+
+	return fsm, nil
+}
+
+// apply the transition `t` to the machine.
+func (fsm *FiniteStateMachine) apply(ctx context.Context, t event.Transition) error {
+	// Answering, or skipping a question - or a page, other than the current
+	// one means the journal doesn't belong to the questionnaire, or is out of
+	// order.
+	if t.Type == event.TransitionForwarded || t.Type == event.TransitionSkipped {
+		target, current := "question "+t.QuestionID, t.QuestionID == fsm.CurrentQuestionID
+
+		if t.PageID != "" {
+			p, ok := fsm.Questionnaire.PageOf(fsm.CurrentQuestionID)
+			target, current = "page "+t.PageID, ok && p.ID == t.PageID
+		}
+
+		if !current {
+			return customapm.TraceError(
+				ctx,
+				customerror.Wrap(
+					errorcatalog.Catalog.MustGet(errorcatalog.ErrReplayInvalidTransition),
+					fmt.Errorf("%s %s, current question is %q", t.Type, target, fsm.CurrentQuestionID),
+				),
+				fsm.GetLogger(),
+				fsm.counterReplayFailed,
+			)
+		}
+	}
+
+	switch t.Type {
+	case event.TransitionStarted:
+		return fsm.Start()
+	case event.TransitionForwarded:
+		fsm.mu.Lock()
+		defer fsm.mu.Unlock()
+
+		if err := fsm.transition(t.Type, status.Runnning); err != nil {
+			return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterReplayFailed)
+		}
+
+		if t.PageID != "" {
+			return fsm.submit(ctx, t.Responses)
+		}
+
+		qst, _ := fsm.Questionnaire.Questions.Get(t.QuestionID)
+
+		s, err := respond(qst, t)
+		if err != nil {
+			return customapm.TraceError(ctx, err, fsm.GetLogger(), fsm.counterReplayFailed)
+		}
+
+		return record(ctx, fsm, s)
+	case event.TransitionSkipped:
+		return Skip(ctx, fsm)
+	case event.TransitionBackward:
+		return fsm.Backward()
+	case event.TransitionJumped:
+		return fsm.Jump(t.QuestionID)
+	case event.TransitionDone:
+		return fsm.Done()
+	}
+
+	return nil
+}
+
+// matches checks that the state of the machine is the same as the recorded
+// one (`e`).
+func (fsm *FiniteStateMachine) matches(e event.Event) error {
+	var diffs []error
+
+	if fsm.State != e.State {
+		diffs = append(diffs, fmt.Errorf("state %q, recorded %q", fsm.State, e.State))
+	}
+
+	if fsm.CurrentQuestionID != e.CurrentQuestion.GetID() {
+		diffs = append(diffs, fmt.Errorf("current question %q, recorded %q", fsm.CurrentQuestionID, e.CurrentQuestion.GetID()))
+	}
+
+	replayed := answerKeys(fsm.Answers)
+	recorded := answerKeys(e.Answers)
+
+	if !reflect.DeepEqual(replayed, recorded) {
+		diffs = append(diffs, fmt.Errorf("answers %v, recorded %v", replayed, recorded))
+	}
+
+	if len(diffs) > 0 {
+		return customerror.Wrap(errorcatalog.Catalog.MustGet(errorcatalog.ErrReplayMismatch), diffs...)
+	}
+
+	return nil
+}
+
+// answerKeys maps question ID to the ID of the chosen option, the provided
+// value, or "skipped".
+func answerKeys(answers *safeorderedmap.SafeOrderedMap[answer.Answer]) map[string]string {
+	keys := map[string]string{}
+
+	if answers == nil {
+		return keys
+	}
+
+	answers.Each(func(questionID string, aswr answer.Answer) {
+		if aswr.IsSkipped() {
+			keys[questionID] = "skipped"
+
+			return
+		}
+
+		if aswr.Option == nil {
+			keys[questionID] = fmt.Sprintf("value:%v", aswr.Value())
+
+			return
+		}
+
+		if opt, err := option.ToOption[any](aswr.Option); err == nil {
+			keys[questionID] = opt.GetID()
+		}
+	})
+
+	return keys
+}
+
 //////
 // Factory.
 //////
@@ -445,6 +1657,12 @@ func New(
 
 	name := shared.RemoveSpacesAndToLower(q.Title)
 
+	// Machines created from the same questionnaire don't share - nor race on
+	// its questions, e.g.: previous question IDs are set while answering.
+	if q.Questions != nil {
+		q.Questions = q.Questions.Clone()
+	}
+
 	f := &FiniteStateMachine{
 		Answers:       safeorderedmap.New[answer.Answer](),
 		Questionnaire: q,
@@ -455,14 +1673,22 @@ func New(
 		callback: cb,
 
 		counterBackward:            metrics.NewInt(fmt.Sprintf("%s.%s.%s.%s", Type, name, status.Runnning+".backward", DefaultMetricCounterLabel)),
+		counterBackwardFailed:      metrics.NewInt(fmt.Sprintf("%s.%s.%s.%s", Type, name, status.Runnning+".backward"+"."+status.Failed, DefaultMetricCounterLabel)),
 		counterCompleted:           metrics.NewInt(fmt.Sprintf("%s.%s.%s.%s", Type, name, status.Completed, DefaultMetricCounterLabel)),
 		counterDone:                metrics.NewInt(fmt.Sprintf("%s.%s.%s.%s", Type, name, status.Done, DefaultMetricCounterLabel)),
+		counterDoneFailed:          metrics.NewInt(fmt.Sprintf("%s.%s.%s.%s", Type, name, status.Done+"."+status.Failed, DefaultMetricCounterLabel)),
 		counterEmitted:             metrics.NewInt(fmt.Sprintf("%s.%s.%s.%s", Type, name, status.Emitted, DefaultMetricCounterLabel)),
 		counterForward:             metrics.NewInt(fmt.Sprintf("%s.%s.%s.%s", Type, name, status.Runnning+".forward", DefaultMetricCounterLabel)),
 		counterForwardFailed:       metrics.NewInt(fmt.Sprintf("%s.%s.%s.%s", Type, name, status.Runnning+".forward"+"."+status.Failed, DefaultMetricCounterLabel)),
 		counterInitialized:         metrics.NewInt(fmt.Sprintf("%s.%s.%s.%s", Type, name, status.Initialized, DefaultMetricCounterLabel)),
+		counterInitializedFailed:   metrics.NewInt(fmt.Sprintf("%s.%s.%s.%s", Type, name, status.Initialized+"."+status.Failed, DefaultMetricCounterLabel)),
 		counterInstantiationFailed: metrics.NewInt(fmt.Sprintf("%s.%s.%s.%s", Type, name, status.Instantiated+"."+status.Failed, DefaultMetricCounterLabel)),
 		counterJump:                metrics.NewInt(fmt.Sprintf("%s.%s.%s.%s", Type, name, status.Runnning+".jump", DefaultMetricCounterLabel)),
+		counterJumpFailed:          metrics.NewInt(fmt.Sprintf("%s.%s.%s.%s", Type, name, status.Runnning+".jump"+"."+status.Failed, DefaultMetricCounterLabel)),
+		counterPersistFailed:       metrics.NewInt(fmt.Sprintf("%s.%s.%s.%s", Type, name, "persist"+"."+status.Failed, DefaultMetricCounterLabel)),
+		counterReplayFailed:        metrics.NewInt(fmt.Sprintf("%s.%s.%s.%s", Type, name, "replay"+"."+status.Failed, DefaultMetricCounterLabel)),
+		counterSkip:                metrics.NewInt(fmt.Sprintf("%s.%s.%s.%s", Type, name, status.Runnning+".skip", DefaultMetricCounterLabel)),
+		counterSkipFailed:          metrics.NewInt(fmt.Sprintf("%s.%s.%s.%s", Type, name, status.Runnning+".skip"+"."+status.Failed, DefaultMetricCounterLabel)),
 	}
 
 	// Validate the storage.
@@ -1,18 +1,31 @@
 package fsm
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"io"
 	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/thalesfsp/questionnaire/answer"
+	"github.com/thalesfsp/questionnaire/blob"
+	"github.com/thalesfsp/questionnaire/errorcatalog"
 	"github.com/thalesfsp/questionnaire/event"
 	"github.com/thalesfsp/questionnaire/internal/shared"
 	"github.com/thalesfsp/questionnaire/option"
 	"github.com/thalesfsp/questionnaire/question"
 	"github.com/thalesfsp/questionnaire/questionnaire"
+	"github.com/thalesfsp/questionnaire/rule"
+	"github.com/thalesfsp/questionnaire/scoring"
+	"github.com/thalesfsp/questionnaire/store"
 	"github.com/thalesfsp/questionnaire/types"
+	"github.com/thalesfsp/questionnaire/validator"
 	"github.com/thalesfsp/status"
 )
 
@@ -152,7 +165,7 @@ func TestNew_branching(t *testing.T) {
 			}
 
 			// Start the machine status.
-			fsm.Start()
+			assert.NoError(t, fsm.Start())
 
 			//////
 			// The next steps simulates the user answering the questions.
@@ -186,7 +199,7 @@ func TestNew_branching(t *testing.T) {
 
 			// Current Q3: "ayfgpl"
 			// Should load Q2: "hmyopedyh"
-			fsm.Backward()
+			assert.NoError(t, fsm.Backward())
 
 			answerOpt02, _ := fsm.Answers.Get(q2ID)
 			assert.Equal(t, 0, answer.GetOption[int](answerOpt02).Value)
@@ -225,7 +238,7 @@ func TestNew_branching(t *testing.T) {
 
 			// Current Q2: "hmyopedyh"
 			// Should load Q1: "wyfc"
-			fsm2.Jump(q1ID)
+			assert.NoError(t, fsm2.Jump(q1ID))
 			// fsm.Jump(q1ID)
 
 			// State should be "Answering".
@@ -236,7 +249,7 @@ func TestNew_branching(t *testing.T) {
 			assert.NoError(t, err)
 
 			// Finish the questionnaire, now by calling Finish().
-			fsm2.Done()
+			assert.NoError(t, fsm2.Done())
 
 			// State should be "Done".
 			assert.Equal(t, status.Done, fsm2.GetState())
@@ -419,7 +432,7 @@ func TestNew_testTypes(t *testing.T) {
 			}
 
 			// Start the machine status.
-			fsm.Start()
+			assert.NoError(t, fsm.Start())
 
 			//////
 			// The next steps simulates the user answering the questions.
@@ -475,3 +488,2328 @@ func TestNew_testTypes(t *testing.T) {
 		})
 	}
 }
+
+func TestForward_invalidOption(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "Should work",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			//////
+			// Question's options.
+			//////
+
+			yes := option.MustNew("Yes", option.WithID("yes"), option.WithNextQuestionID("2"))
+			no := option.MustNew("No", option.WithID("no"), option.WithState(status.Completed))
+
+			n1 := option.MustNew(1, option.WithID("n1"), option.WithState(status.Completed))
+
+			//////
+			// Questions.
+			//////
+
+			q1 := question.MustNew[string]("1", "Do you code?", types.SingleSelect,
+				question.WithOption(yes, no),
+			)
+
+			q2 := question.MustNew[int]("2", "How many years?", types.SingleSelect,
+				question.WithOption(n1),
+			)
+
+			q, err := questionnaire.New("Simple Survey - 3", q1, q2)
+			assert.NoError(t, err)
+
+			fsm, err := New(ctx, "12345", *q, nil)
+			assert.NoError(t, err)
+
+			assert.NoError(t, fsm.Start())
+
+			//////
+			// Tampering attempts.
+			//////
+
+			// Option from another question.
+			err = Forward(ctx, fsm, n1)
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardInvalidOption))
+
+			// Unknown option.
+			err = Forward(ctx, fsm, option.MustNew("Yes", option.WithNextQuestionID("2")))
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardInvalidOption))
+
+			// Known option, different value.
+			err = Forward(ctx, fsm, option.MustNew("Maybe", option.WithID("yes")))
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardInvalidOption))
+
+			// Known option, different type.
+			err = Forward(ctx, fsm, option.MustNew(1, option.WithID("yes")))
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardInvalidOption))
+
+			assert.Equal(t, 0, fsm.Answers.Size())
+			assert.Equal(t, "1", fsm.CurrentQuestionID)
+
+			//////
+			// Forged branching is ignored, the questionnaire's one is used.
+			//////
+
+			forged := option.MustNew("No", option.WithID("no"), option.WithNextQuestionID("2"))
+
+			err = Forward(ctx, fsm, forged)
+			assert.NoError(t, err)
+			assert.Equal(t, "1", fsm.CurrentQuestionID)
+			assert.Equal(t, status.Completed, fsm.GetState())
+		})
+	}
+}
+
+func TestSkip(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "Should work",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			yes := option.MustNew("Yes", option.WithNextQuestionID("2"))
+
+			n1 := option.MustNew(1, option.WithNextQuestionID("3"))
+
+			bTrue := option.MustNew(true, option.WithState(status.Completed))
+
+			q1 := question.MustNew[string]("1", "Do you code?", types.SingleSelect,
+				question.WithOption(yes),
+				question.WithRequired(true),
+			)
+
+			q2 := question.MustNew[int]("2", "How many years?", types.SingleSelect,
+				question.WithOption(n1),
+				question.WithNextQuestionID("3"),
+			)
+
+			q3 := question.MustNew[bool]("3", "Do you like Go?", types.SingleSelect,
+				question.WithOption(bTrue),
+				question.WithState(status.Completed),
+			)
+
+			q, err := questionnaire.New("Simple Survey - 4", q1, q2, q3)
+			assert.NoError(t, err)
+
+			fsm, err := New(ctx, "12345", *q, nil)
+			assert.NoError(t, err)
+
+			assert.NoError(t, fsm.Start())
+
+			// Required questions can't be skipped.
+			err = Skip(ctx, fsm)
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrSkipRequired))
+			assert.Equal(t, "1", fsm.CurrentQuestionID)
+			assert.Equal(t, 0, fsm.Answers.Size())
+
+			err = Forward(ctx, fsm, yes)
+			assert.NoError(t, err)
+			assert.Equal(t, "2", fsm.CurrentQuestionID)
+
+			// Follows the question's default next question.
+			err = Skip(ctx, fsm)
+			assert.NoError(t, err)
+			assert.Equal(t, "3", fsm.CurrentQuestionID)
+
+			answerQ2, ok := fsm.Answers.Get("2")
+			assert.True(t, ok)
+			assert.True(t, answerQ2.IsSkipped())
+			assert.Nil(t, answerQ2.GetOption())
+
+			// Follows the question's default state, and skipped answers count.
+			err = Skip(ctx, fsm)
+			assert.NoError(t, err)
+			assert.Equal(t, "3", fsm.CurrentQuestionID)
+			assert.Equal(t, 3, fsm.Answers.Size())
+			assert.Equal(t, status.Completed, fsm.GetState())
+
+			// Skipped required questions are invalid.
+			skipped, err := answer.NewSkipped(q1)
+			assert.NoError(t, err)
+			assert.ErrorIs(t, skipped.Validate(), errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerOptionRequired))
+		})
+	}
+}
+
+func TestForward_rules(t *testing.T) {
+	tests := []struct {
+		name    string
+		age     option.Option[int]
+		country option.Option[string]
+		want    string
+	}{
+		{
+			name:    "Should route minors to the guardian question",
+			age:     option.MustNew(10, option.WithID("10")),
+			country: option.MustNew("BR", option.WithID("BR"), option.WithNextQuestionID("age")),
+			want:    "guardian",
+		},
+		{
+			name:    "Should route brazilian adults to the CPF question",
+			age:     option.MustNew(30, option.WithID("30")),
+			country: option.MustNew("BR", option.WithID("BR"), option.WithNextQuestionID("age")),
+			want:    "cpf",
+		},
+		{
+			name:    "Should route other adults to the SSN question",
+			age:     option.MustNew(30, option.WithID("30")),
+			country: option.MustNew("US", option.WithID("US"), option.WithNextQuestionID("age")),
+			want:    "ssn",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			done := option.MustNew(true, option.WithState(status.Completed))
+
+			qCountry := question.MustNew[string]("country", "Where do you live?", types.SingleSelect,
+				question.WithOption(
+					option.MustNew("BR", option.WithID("BR"), option.WithNextQuestionID("age")),
+					option.MustNew("US", option.WithID("US"), option.WithNextQuestionID("age")),
+				),
+			)
+
+			qAge := question.MustNew[int]("age", "How old are you?", types.SingleSelect,
+				question.WithOption(
+					option.MustNew(10, option.WithID("10")),
+					option.MustNew(30, option.WithID("30")),
+				),
+				question.WithRule(
+					rule.GoTo("guardian", rule.If("age", rule.LessThan, 18)),
+					rule.GoTo("cpf", rule.If("country", rule.Equal, "BR")),
+					rule.GoTo("ssn"),
+				),
+			)
+
+			qGuardian := question.MustNew[bool]("guardian", "Guardian?", types.SingleSelect, question.WithOption(done))
+			qCPF := question.MustNew[bool]("cpf", "CPF?", types.SingleSelect, question.WithOption(done))
+			qSSN := question.MustNew[bool]("ssn", "SSN?", types.SingleSelect, question.WithOption(done))
+
+			q, err := questionnaire.New("Simple Survey - 5", qCountry, qAge, qGuardian, qCPF, qSSN)
+			assert.NoError(t, err)
+
+			fsm, err := New(ctx, "12345", *q, nil)
+			assert.NoError(t, err)
+
+			assert.NoError(t, fsm.Start())
+
+			assert.NoError(t, Forward(ctx, fsm, tt.country))
+			assert.Equal(t, "age", fsm.CurrentQuestionID)
+
+			//////
+			// Simulate saving to, and loading from the database. Rules are
+			// persisted with the questionnaire.
+			//////
+
+			b, err := shared.Marshal(fsm.Dump())
+			assert.NoError(t, err)
+
+			var loadedDump event.Event
+			assert.NoError(t, shared.Unmarshal(b, &loadedDump))
+
+			fsm2, err := New(ctx, "12345", loadedDump.Questionnaire, nil)
+			assert.NoError(t, err)
+
+			fsm2 = Load(ctx, fsm2, loadedDump)
+
+			assert.NoError(t, Forward(ctx, fsm2, tt.age))
+			assert.Equal(t, tt.want, fsm2.CurrentQuestionID)
+		})
+	}
+}
+
+func TestNew_loadedFromStorage(t *testing.T) {
+	tests := []struct {
+		name      string
+		marshal   func(v any) ([]byte, error)
+		unmarshal func(data []byte, v any) error
+	}{
+		{
+			name:      "Should work - JSON",
+			marshal:   shared.Marshal,
+			unmarshal: shared.Unmarshal,
+		},
+		{
+			name:      "Should work - BSON",
+			marshal:   shared.MarshalBSON,
+			unmarshal: shared.UnmarshalBSON,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			//////
+			// Fully branched questionnaire: static branching, states, rules,
+			// and question-level routing.
+			//////
+
+			red := option.MustNew("Red", option.WithID("red"), option.WithNextQuestionID("years"))
+			blue := option.MustNew("Blue", option.WithID("blue"), option.WithNextQuestionID("go"))
+
+			n0 := option.MustNew(0, option.WithID("n0"), option.WithRule(
+				rule.GoTo("go", rule.If("color", rule.Equal, "Red")),
+			))
+			n1 := option.MustNew(1, option.WithID("n1"), option.WithState(status.Completed))
+
+			bTrue := option.MustNew(true, option.WithID("true"), option.WithNextQuestionID("feedback"))
+			bFalse := option.MustNew(false, option.WithID("false"), option.WithNextQuestionID("color"))
+
+			ok := option.MustNew("Ok", option.WithID("ok"), option.WithState(status.Completed))
+
+			qColor := question.MustNew[string]("color", "What's your favorite color?", types.SingleSelect,
+				question.WithOption(red, blue),
+			)
+
+			qYears := question.MustNew[int]("years", "How many years of experience?", types.SingleSelect,
+				question.WithOption(n0, n1),
+			)
+
+			qGo := question.MustNew[bool]("go", "Do you know Go?", types.SingleSelect,
+				question.WithOption(bTrue, bFalse),
+			)
+
+			qFeedback := question.MustNew[string]("feedback", "Any feedback?", types.SingleSelect,
+				question.WithOption(ok),
+				question.WithState(status.Completed),
+			)
+
+			q, err := questionnaire.New("Simple Survey - 6", qColor, qYears, qGo, qFeedback)
+			assert.NoError(t, err)
+
+			//////
+			// Simulate saving to, and loading from the database.
+			//////
+
+			b, err := tt.marshal(q)
+			assert.NoError(t, err)
+
+			var loaded questionnaire.Questionnaire
+			assert.NoError(t, tt.unmarshal(b, &loaded))
+
+			assert.Equal(t, q.Hash, loaded.Hash)
+			assert.Equal(t, q.Questions.Keys(), loaded.Questions.Keys())
+
+			//////
+			// Run end to end.
+			//////
+
+			fsm, err := New(ctx, "12345", loaded, nil)
+			assert.NoError(t, err)
+
+			assert.NoError(t, fsm.Start())
+			assert.Equal(t, "color", fsm.CurrentQuestionID)
+
+			assert.NoError(t, Forward(ctx, fsm, red))
+			assert.Equal(t, "years", fsm.CurrentQuestionID)
+
+			assert.NoError(t, Forward(ctx, fsm, n0))
+			assert.Equal(t, "go", fsm.CurrentQuestionID)
+
+			assert.NoError(t, Forward(ctx, fsm, bTrue))
+			assert.Equal(t, "feedback", fsm.CurrentQuestionID)
+
+			assert.NoError(t, Skip(ctx, fsm))
+			assert.Equal(t, status.Completed, fsm.GetState())
+			assert.Equal(t, 4, fsm.Answers.Size())
+
+			aswr, _ := fsm.Answers.Get("years")
+			assert.Equal(t, 0, answer.GetOption[int](aswr).Value)
+			assert.Equal(t, "years", answer.GetOption[int](aswr).GetQuestionID())
+		})
+	}
+}
+
+func TestLoad_backward(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "Should work",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			red := option.MustNew("Red", option.WithNextQuestionID("2"))
+			n1 := option.MustNew(1, option.WithNextQuestionID("3"))
+			bTrue := option.MustNew(true, option.WithState(status.Completed))
+
+			q1 := question.MustNew[string]("1", "Color?", types.SingleSelect, question.WithOption(red))
+			q2 := question.MustNew[int]("2", "Years?", types.SingleSelect, question.WithOption(n1))
+			q3 := question.MustNew[bool]("3", "Go?", types.SingleSelect, question.WithOption(bTrue))
+
+			q, err := questionnaire.New("Simple Survey - 8", q1, q2, q3)
+			assert.NoError(t, err)
+
+			fsm, err := New(ctx, "12345", *q, nil)
+			assert.NoError(t, err)
+
+			assert.NoError(t, fsm.Start())
+			assert.NoError(t, Forward(ctx, fsm, red))
+
+			//////
+			// Simulate saving to, and loading from the database.
+			//////
+
+			b, err := shared.Marshal(fsm.Dump())
+			assert.NoError(t, err)
+
+			var dump event.Event
+			assert.NoError(t, shared.Unmarshal(b, &dump))
+
+			fsm2, err := New(ctx, "12345", *q, nil)
+			assert.NoError(t, err)
+
+			fsm2 = Load(ctx, fsm2, dump)
+
+			assert.NoError(t, Forward(ctx, fsm2, n1))
+			assert.Equal(t, "3", fsm2.CurrentQuestionID)
+
+			assert.NoError(t, fsm2.Backward())
+			assert.Equal(t, "2", fsm2.CurrentQuestionID)
+
+			assert.NoError(t, fsm2.Backward())
+			assert.Equal(t, "1", fsm2.CurrentQuestionID)
+		})
+	}
+}
+
+func TestResume(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "Should work",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			red := option.MustNew("Red", option.WithNextQuestionID("2"))
+			n1 := option.MustNew(1, option.WithNextQuestionID("3"))
+			bTrue := option.MustNew(true, option.WithState(status.Completed))
+
+			q1 := question.MustNew[string]("1", "Color?", types.SingleSelect, question.WithOption(red))
+			q2 := question.MustNew[int]("2", "Years?", types.SingleSelect, question.WithOption(n1))
+			q3 := question.MustNew[bool]("3", "Go?", types.SingleSelect, question.WithOption(bTrue))
+
+			q, err := questionnaire.New("Simple Survey - 7", q1, q2, q3)
+			assert.NoError(t, err)
+
+			s := store.NewMemory()
+
+			fsm, err := New(ctx, "12345", *q, nil)
+			assert.NoError(t, err)
+
+			fsm.SetStore(s)
+
+			assert.NoError(t, fsm.Start())
+
+			assert.NoError(t, Forward(ctx, fsm, red))
+			assert.NoError(t, Forward(ctx, fsm, n1))
+
+			// Every emitted event is persisted.
+			journal, err := s.Journal(ctx, "12345", q.ID)
+			assert.NoError(t, err)
+			assert.Len(t, journal, 3)
+
+			sessions, err := s.List(ctx, store.Filter{UserID: "12345"})
+			assert.NoError(t, err)
+			assert.Len(t, sessions, 1)
+			assert.Equal(t, q.ID, sessions[0].QuestionnaireID)
+
+			//////
+			// Resume in, for example, another request.
+			//////
+
+			fsm2, err := Resume(ctx, s, "12345", q.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, "3", fsm2.CurrentQuestionID)
+			assert.Equal(t, 2, fsm2.Answers.Size())
+			assert.Len(t, fsm2.GetJournal(), 3)
+
+			assert.NoError(t, Forward(ctx, fsm2, bTrue))
+			assert.Equal(t, status.Completed, fsm2.GetState())
+
+			snapshot, err := s.Load(ctx, "12345", q.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, status.Completed, snapshot.State)
+			assert.Equal(t, 3, snapshot.TotalAnswers)
+
+			_, err = Resume(ctx, s, "54321", q.ID)
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrStoreSessionNotFound))
+		})
+	}
+}
+
+// contextKey is the key of the value carried by the caller's context.
+type contextKey struct{}
+
+// contextStore records the value carried by the context of appends.
+type contextStore struct {
+	*store.Memory
+
+	values []any
+}
+
+// Append records the value carried by `ctx`, and appends the event.
+func (s *contextStore) Append(ctx context.Context, e event.Event) error {
+	s.values = append(s.values, ctx.Value(contextKey{}))
+
+	return s.Memory.Append(ctx, e)
+}
+
+func TestForward_context(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "Should work",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.WithValue(context.Background(), contextKey{}, "request")
+
+			red := option.MustNew("Red", option.WithNextQuestionID("2"))
+			bTrue := option.MustNew(true, option.WithState(status.Completed))
+
+			q1 := question.MustNew[string]("1", "Color?", types.SingleSelect, question.WithOption(red))
+			q2 := question.MustNew[bool]("2", "Go?", types.SingleSelect, question.WithOption(bTrue))
+
+			q, err := questionnaire.New("Simple Survey - 13", q1, q2)
+			assert.NoError(t, err)
+
+			s := &contextStore{Memory: store.NewMemory()}
+
+			fsm, err := New(ctx, "12345", *q, nil)
+			assert.NoError(t, err)
+
+			fsm.SetStore(s)
+
+			assert.NoError(t, fsm.Start())
+
+			assert.NoError(t, Forward(ctx, fsm, red))
+			assert.NoError(t, Forward(ctx, fsm, bTrue))
+
+			// Starting has no caller's context, answering does.
+			assert.Equal(t, []any{nil, "request", "request"}, s.values)
+		})
+	}
+}
+
+func TestReplay(t *testing.T) {
+	tests := []struct {
+		name    string
+		tamper  func(journal []event.Event) []event.Event
+		wantErr string
+	}{
+		{
+			name:   "Should work",
+			tamper: func(journal []event.Event) []event.Event { return journal },
+		},
+		{
+			name: "Should fail - recorded state differs",
+			tamper: func(journal []event.Event) []event.Event {
+				journal[len(journal)-1].State = status.Runnning
+
+				return journal
+			},
+			wantErr: errorcatalog.ErrReplayMismatch,
+		},
+		{
+			name: "Should fail - out of order",
+			tamper: func(journal []event.Event) []event.Event {
+				journal[1], journal[2] = journal[2], journal[1]
+
+				return journal
+			},
+			wantErr: errorcatalog.ErrReplayInvalidTransition,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			red := option.MustNew("Red", option.WithNextQuestionID("2"))
+			n1 := option.MustNew(1, option.WithNextQuestionID("3"))
+			bTrue := option.MustNew(true, option.WithState(status.Completed))
+
+			q1 := question.MustNew[string]("1", "Color?", types.SingleSelect, question.WithOption(red))
+			q2 := question.MustNew[int](
+				"2",
+				"Years?",
+				types.SingleSelect,
+				question.WithOption(n1),
+				question.WithNextQuestionID("3"),
+			)
+			q3 := question.MustNew[bool]("3", "Go?", types.SingleSelect, question.WithOption(bTrue))
+
+			q, err := questionnaire.New("Simple Survey - 8", q1, q2, q3)
+			assert.NoError(t, err)
+
+			s := store.NewMemory()
+
+			fsm, err := New(ctx, "12345", *q, nil)
+			assert.NoError(t, err)
+
+			fsm.SetStore(s)
+
+			assert.NoError(t, fsm.Start())
+
+			assert.NoError(t, Forward(ctx, fsm, red))
+			assert.NoError(t, Skip(ctx, fsm))
+
+			assert.NoError(t, fsm.Backward())
+
+			assert.NoError(t, Forward(ctx, fsm, n1))
+
+			assert.NoError(t, fsm.Jump("1"))
+
+			assert.NoError(t, Forward(ctx, fsm, red))
+			assert.NoError(t, Forward(ctx, fsm, n1))
+			assert.NoError(t, Forward(ctx, fsm, bTrue))
+
+			fsm.Dump()
+			assert.NoError(t, fsm.Done())
+
+			// Journal as loaded from storage.
+			journal, err := s.Journal(ctx, "12345", q.ID)
+			assert.NoError(t, err)
+			assert.Len(t, journal, 11)
+			assert.Equal(t, event.Transition{Type: event.TransitionStarted}, journal[0].Transition)
+			assert.Equal(t, event.Transition{
+				Type:       event.TransitionForwarded,
+				QuestionID: "1",
+				OptionID:   red.GetID(),
+			}, journal[1].Transition)
+			assert.Equal(t, event.Transition{Type: event.TransitionSkipped, QuestionID: "2"}, journal[2].Transition)
+			assert.Equal(t, event.Transition{Type: event.TransitionJumped, QuestionID: "1"}, journal[5].Transition)
+			assert.Equal(t, event.TransitionDumped, journal[9].Transition.Type)
+			assert.Equal(t, event.TransitionDone, journal[10].Transition.Type)
+
+			replayed, err := Replay(ctx, *q, tt.tamper(journal))
+			if tt.wantErr != "" {
+				assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(tt.wantErr))
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, fsm.GetState(), replayed.GetState())
+			assert.Equal(t, fsm.CurrentQuestionID, replayed.CurrentQuestionID)
+			assert.Equal(t, fsm.PreviousQuestionID, replayed.PreviousQuestionID)
+			assert.Equal(t, fsm.Answers.Keys(), replayed.Answers.Keys())
+			assert.Len(t, replayed.GetJournal(), 10)
+		})
+	}
+}
+
+func TestSetJournalMode(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "Should work",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			red := option.MustNew("Red", option.WithNextQuestionID("2"))
+			n1 := option.MustNew(1, option.WithNextQuestionID("3"))
+			bTrue := option.MustNew(true, option.WithState(status.Completed))
+
+			q1 := question.MustNew[string]("1", "Color?", types.SingleSelect, question.WithOption(red))
+			q2 := question.MustNew[int]("2", "Years?", types.SingleSelect, question.WithOption(n1))
+			q3 := question.MustNew[bool]("3", "Go?", types.SingleSelect, question.WithOption(bTrue))
+
+			q, err := questionnaire.New("Simple Survey - 9", q1, q2, q3)
+			assert.NoError(t, err)
+
+			// Callbacks receive the journal in both modes - materialized, if
+			// compact.
+			lengths := map[JournalMode][]int{}
+
+			var sizes []int
+
+			run := func(mode JournalMode) *FiniteStateMachine {
+				fsm, err := New(ctx, "12345", *q, func(e event.Event, journal []event.Event) {
+					lengths[mode] = append(lengths[mode], len(journal))
+				})
+				assert.NoError(t, err)
+
+				fsm.SetCompactCallback(func(e event.Event, journal *event.CompactJournal) {
+					sizes = append(sizes, journal.Size())
+				})
+
+				fsm.SetJournalMode(mode)
+
+				assert.NoError(t, fsm.Start())
+
+				assert.NoError(t, Forward(ctx, fsm, red))
+
+				assert.NoError(t, fsm.Backward())
+
+				assert.NoError(t, Forward(ctx, fsm, red))
+				assert.NoError(t, Forward(ctx, fsm, n1))
+				assert.NoError(t, Forward(ctx, fsm, bTrue))
+
+				assert.NoError(t, fsm.Done())
+
+				return fsm
+			}
+
+			full := run(JournalFull)
+			compact := run(JournalCompact)
+
+			assert.Nil(t, full.GetCompactJournal())
+			assert.Empty(t, compact.Journal)
+			assert.Equal(t, 7, compact.GetCompactJournal().Size())
+
+			assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6}, sizes)
+			assert.Equal(t, sizes, lengths[JournalFull])
+			assert.Equal(t, sizes, lengths[JournalCompact])
+
+			// Compacting the full journal, and materializing it back, is
+			// lossless - previous question IDs included.
+			var fromFull event.CompactJournal
+
+			for _, e := range full.Journal {
+				fromFull.Add(e)
+			}
+
+			materialized, err := fromFull.Materialize()
+			assert.NoError(t, err)
+			assert.Equal(t, full.Journal, materialized)
+
+			previous, _ := materialized[2].Questionnaire.Questions.Get("2")
+			assert.Equal(t, "1", previous.PreviousQuestionID)
+
+			// The questionnaire is stored once, as it was - the machine keeps
+			// changing its questions.
+			assert.Len(t, compact.GetCompactJournal().Questionnaires, 1)
+
+			stored, _ := compact.GetCompactJournal().Questionnaires[q.Hash].Questions.Get("2")
+			assert.Empty(t, stored.PreviousQuestionID)
+
+			live, _ := compact.Questionnaire.Questions.Get("2")
+			assert.Equal(t, "1", live.PreviousQuestionID)
+
+			fullJSON, err := shared.Marshal(full.GetJournal())
+			assert.NoError(t, err)
+
+			compactJSON, err := shared.Marshal(compact.GetCompactJournal())
+			assert.NoError(t, err)
+
+			assert.Less(t, len(compactJSON), len(fullJSON)/2)
+
+			// Materialize the journal as loaded from storage.
+			var loaded event.CompactJournal
+
+			assert.NoError(t, shared.Unmarshal(compactJSON, &loaded))
+
+			journal, err := loaded.Materialize()
+			assert.NoError(t, err)
+			assert.Len(t, journal, 7)
+
+			for i, e := range journal {
+				assert.Equal(t, full.Journal[i].Transition, e.Transition)
+				assert.Equal(t, full.Journal[i].State, e.State)
+				assert.Equal(t, full.Journal[i].CurrentQuestion.GetID(), e.CurrentQuestion.GetID())
+				assert.Equal(t, full.Journal[i].PreviousQuestion.GetID(), e.PreviousQuestion.GetID())
+				assert.Equal(t, full.Journal[i].CurrentQuestionIndex, e.CurrentQuestionIndex)
+			}
+
+			// Answers are as they were at the time of each event.
+			assert.Equal(t, 1, journal[1].TotalAnswers)
+			assert.Equal(t, 3, journal[6].TotalAnswers)
+			assert.Equal(t, q.Hash, journal[0].Questionnaire.Hash)
+
+			// The materialized journal can be replayed.
+			replayed, err := Replay(ctx, *q, journal)
+			assert.NoError(t, err)
+			assert.Equal(t, status.Done, replayed.GetState())
+
+			// Switching modes keeps the entries.
+			compact.SetJournalMode(JournalFull)
+
+			assert.Nil(t, compact.GetCompactJournal())
+			assert.Len(t, compact.GetJournal(), 7)
+		})
+	}
+}
+
+func TestForward_concurrent(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "Should work",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			red := option.MustNew("Red", option.WithNextQuestionID("2"))
+			n1 := option.MustNew(1, option.WithNextQuestionID("3"))
+			bTrue := option.MustNew(true, option.WithState(status.Completed))
+
+			q1 := question.MustNew[string]("1", "Color?", types.SingleSelect, question.WithOption(red))
+			q2 := question.MustNew[int]("2", "Years?", types.SingleSelect, question.WithOption(n1))
+			q3 := question.MustNew[bool]("3", "Go?", types.SingleSelect, question.WithOption(bTrue))
+
+			q, err := questionnaire.New("Simple Survey - 10", q1, q2, q3)
+			assert.NoError(t, err)
+
+			s := store.NewMemory()
+
+			fsm, err := New(ctx, "12345", *q, nil)
+			assert.NoError(t, err)
+
+			fsm.SetStore(s)
+
+			assert.NoError(t, fsm.Start())
+
+			// Double-click submit: only one of the requests answers the
+			// question, the others fail as the option doesn't belong to the
+			// new current question.
+			var (
+				wg     sync.WaitGroup
+				failed atomic.Int32
+			)
+
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+
+				go func() {
+					defer wg.Done()
+
+					if err := Forward(ctx, fsm, red); err != nil {
+						failed.Add(1)
+					}
+
+					fsm.GetState()
+					fsm.GetJournal()
+				}()
+			}
+
+			wg.Wait()
+
+			assert.Equal(t, int32(9), failed.Load())
+			assert.Equal(t, "2", fsm.CurrentQuestionID)
+			assert.Equal(t, int64(2), fsm.Version)
+
+			journal, err := s.Journal(ctx, "12345", q.ID)
+			assert.NoError(t, err)
+			assert.Len(t, journal, 2)
+			assert.Equal(t, int64(1), journal[0].Version)
+			assert.Equal(t, int64(2), journal[1].Version)
+
+			//////
+			// Two requests resume the same session, the slower one is stale.
+			//////
+
+			fsm1, err := Resume(ctx, s, "12345", q.ID)
+			assert.NoError(t, err)
+
+			fsm2, err := Resume(ctx, s, "12345", q.ID)
+			assert.NoError(t, err)
+
+			emitted := 0
+
+			fsm2.SetCallback(func(e event.Event, journal []event.Event) {
+				emitted++
+			})
+
+			assert.NoError(t, Forward(ctx, fsm1, n1))
+
+			err = Forward(ctx, fsm2, n1)
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrStoreVersionConflict))
+
+			// The rejected transition leaves the stale machine as it was, and
+			// isn't emitted.
+			assert.Equal(t, 0, emitted)
+			assert.Equal(t, status.Runnning, fsm2.GetState())
+			assert.Equal(t, "2", fsm2.CurrentQuestionID)
+			assert.Equal(t, "1", fsm2.PreviousQuestionID)
+			assert.Equal(t, int64(2), fsm2.Version)
+			assert.Equal(t, []string{"1"}, fsm2.Answers.Keys())
+			assert.Len(t, fsm2.GetJournal(), 2)
+
+			stale, _ := fsm2.Questionnaire.Questions.Get("3")
+			assert.Empty(t, stale.PreviousQuestionID)
+
+			// Nothing is appended by stale machines.
+			journal, err = s.Journal(ctx, "12345", q.ID)
+			assert.NoError(t, err)
+			assert.Len(t, journal, 3)
+
+			// Machines don't share the questionnaire's questions.
+			qst, _ := q.Questions.Get("2")
+			assert.Empty(t, qst.PreviousQuestionID)
+		})
+	}
+}
+
+func TestTransitions(t *testing.T) {
+	notStarted := errorcatalog.Catalog.MustGet(errorcatalog.ErrFSMNotStarted)
+	finished := errorcatalog.Catalog.MustGet(errorcatalog.ErrFSMAlreadyFinished)
+	invalid := errorcatalog.Catalog.MustGet(errorcatalog.ErrFSMInvalidTransition)
+
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "Should work",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			red := option.MustNew("Red", option.WithNextQuestionID("2"))
+			n1 := option.MustNew(1, option.WithState(status.Completed))
+			n2 := option.MustNew(2, option.WithState(status.Initialized))
+
+			q1 := question.MustNew[string]("1", "Color?", types.SingleSelect, question.WithOption(red))
+			q2 := question.MustNew[int]("2", "Years?", types.SingleSelect, question.WithOption(n1), question.WithOption(n2))
+
+			q, err := questionnaire.New("Simple Survey - 11", q1, q2)
+			assert.NoError(t, err)
+
+			fsm, err := New(ctx, "12345", *q, nil)
+			assert.NoError(t, err)
+
+			// Not started.
+			assert.ErrorIs(t, Forward(ctx, fsm, red), notStarted)
+			assert.ErrorIs(t, Skip(ctx, fsm), notStarted)
+			assert.ErrorIs(t, fsm.Backward(), notStarted)
+			assert.ErrorIs(t, fsm.Jump("1"), notStarted)
+			assert.ErrorIs(t, fsm.Done(), notStarted)
+			assert.Equal(t, status.Initialized, fsm.GetState())
+			assert.Empty(t, fsm.GetJournal())
+
+			assert.NoError(t, fsm.Start())
+			assert.ErrorIs(t, fsm.Start(), invalid)
+
+			assert.NoError(t, Forward(ctx, fsm, red))
+
+			// Options can't set states the machine can't transition to.
+			assert.ErrorIs(t, Forward(ctx, fsm, n2), invalid)
+			assert.Equal(t, "2", fsm.CurrentQuestionID)
+			assert.Equal(t, 1, fsm.Answers.Size())
+
+			assert.NoError(t, Forward(ctx, fsm, n1))
+			assert.Equal(t, status.Completed, fsm.GetState())
+
+			// Failed answers don't change the state, nor emit.
+			journal := fsm.GetJournal()
+
+			assert.ErrorIs(t, Forward(ctx, fsm, red), errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardInvalidOption))
+			assert.ErrorIs(t, ForwardText(ctx, fsm, "1"), errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardInvalidInput))
+			assert.Equal(t, status.Completed, fsm.GetState())
+			assert.Len(t, fsm.GetJournal(), len(journal))
+
+			// Completed questionnaires can be revised.
+			assert.NoError(t, fsm.Jump("1"))
+			assert.Equal(t, status.Runnning, fsm.GetState())
+
+			assert.NoError(t, fsm.Done())
+
+			// Already finished.
+			assert.ErrorIs(t, Forward(ctx, fsm, red), finished)
+			assert.ErrorIs(t, Skip(ctx, fsm), finished)
+			assert.ErrorIs(t, fsm.Backward(), finished)
+			assert.ErrorIs(t, fsm.Jump("1"), finished)
+			assert.ErrorIs(t, fsm.Done(), finished)
+			assert.ErrorIs(t, fsm.Start(), finished)
+			assert.Equal(t, status.Done, fsm.GetState())
+
+			assert.True(t, CanTransition(status.Completed, status.Done))
+			assert.True(t, CanTransition(status.Runnning, status.Done))
+			assert.False(t, CanTransition(status.Done, status.Runnning))
+		})
+	}
+}
+
+func TestForwardText(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "Should work",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			yes := option.MustNew("Yes", option.WithNextQuestionID("name"))
+			done := option.MustNew(true, option.WithState(status.Completed))
+
+			q1 := question.MustNew[string]("consent", "Consent?", types.SingleSelect, question.WithOption(yes))
+			q2 := question.MustNew[string](
+				"name",
+				"Name?",
+				types.Text,
+				question.WithRequired(true),
+				question.WithText(question.Text{MinLength: 2, MaxLength: 10, Pattern: `^\p{L}+$`, Trim: true}),
+				question.WithNextQuestionID("go"),
+				question.WithRule(rule.GoTo("admin", rule.If("name", rule.Equal, "root"))),
+			)
+			q3 := question.MustNew[bool]("go", "Go?", types.SingleSelect, question.WithOption(done))
+			q4 := question.MustNew[string]("admin", "Why?", types.Text, question.WithState(status.Completed))
+
+			q, err := questionnaire.NewWithParams(
+				"Simple Survey - 12",
+				[]question.Question{q1, q2, q3, q4},
+				questionnaire.WithValidation(true),
+			)
+			assert.NoError(t, err)
+
+			fsm, err := New(ctx, "12345", *q, nil)
+			assert.NoError(t, err)
+
+			assert.NoError(t, fsm.Start())
+
+			// Only questions accepting values can be answered with text.
+			err = ForwardText(ctx, fsm, "Yes")
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardInvalidInput))
+
+			assert.NoError(t, Forward(ctx, fsm, yes))
+
+			err = ForwardText(ctx, fsm, "   ")
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerOptionRequired))
+
+			err = ForwardText(ctx, fsm, "J")
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerTextLength))
+
+			err = ForwardText(ctx, fsm, "J0hn")
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerTextPattern))
+
+			assert.Equal(t, "name", fsm.CurrentQuestionID)
+
+			assert.NoError(t, ForwardText(ctx, fsm, "  João "))
+			assert.Equal(t, "go", fsm.CurrentQuestionID)
+
+			aswr, ok := fsm.Answers.Get("name")
+			assert.True(t, ok)
+			assert.Equal(t, "João", aswr.Text)
+			assert.Equal(t, "João", aswr.Value())
+
+			// Rules are evaluated against the text.
+			assert.NoError(t, fsm.Backward())
+			assert.NoError(t, ForwardText(ctx, fsm, "root"))
+			assert.Equal(t, "admin", fsm.CurrentQuestionID)
+
+			assert.NoError(t, ForwardText(ctx, fsm, "Testing"))
+			assert.Equal(t, status.Completed, fsm.GetState())
+
+			// Survives storage, and replays.
+			b, err := shared.Marshal(fsm.GetJournal())
+			assert.NoError(t, err)
+
+			var journal []event.Event
+
+			assert.NoError(t, shared.Unmarshal(b, &journal))
+
+			assert.Equal(t, "root", journal[4].Transition.Value)
+
+			replayed, err := Replay(ctx, *q, journal)
+			assert.NoError(t, err)
+
+			aswr, _ = replayed.Answers.Get("admin")
+			assert.Equal(t, "Testing", aswr.Text)
+		})
+	}
+}
+
+func TestForwardMany(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "Should work",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			a := option.MustNew("A", option.WithID("a"))
+			b := option.MustNew("B", option.WithID("b"), option.WithNextQuestionID("b"))
+			c := option.MustNew("C", option.WithID("c"), option.WithNextQuestionID("c"))
+			d := option.MustNew("D", option.WithID("d"))
+			e := option.MustNew("E", option.WithID("e"), option.WithRule(rule.GoTo("e", rule.If("pick", rule.Contains, "A"))))
+			done := option.MustNew(true, option.WithState(status.Completed))
+
+			q1 := question.MustNew[string](
+				"pick",
+				"Pick?",
+				types.MultipleSelect,
+				question.WithRequired(true),
+				question.WithOption(a, b, c, d, e),
+				question.WithSelection(question.Selection{Min: 2, Max: 3}),
+				question.WithNextQuestionID("c"),
+			)
+			q2 := question.MustNew[bool]("b", "B?", types.SingleSelect, question.WithOption(done))
+			q3 := question.MustNew[bool]("c", "C?", types.SingleSelect, question.WithOption(done))
+			q4 := question.MustNew[bool]("e", "E?", types.SingleSelect, question.WithOption(done))
+
+			q, err := questionnaire.NewWithParams(
+				"Simple Survey - 13",
+				[]question.Question{q1, q2, q3, q4},
+				questionnaire.WithValidation(true),
+			)
+			assert.NoError(t, err)
+
+			fsm, err := New(ctx, "12345", *q, nil)
+			assert.NoError(t, err)
+
+			assert.NoError(t, fsm.Start())
+
+			err = ForwardMany(ctx, fsm)
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerOptionRequired))
+
+			err = ForwardMany(ctx, fsm, "a")
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerSelectionCount))
+
+			err = ForwardMany(ctx, fsm, "a", "b", "c", "d")
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerSelectionCount))
+
+			err = ForwardMany(ctx, fsm, "a", "a")
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardInvalidOption))
+
+			err = ForwardMany(ctx, fsm, "a", "yes")
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardInvalidOption))
+
+			// The first selected option - in the question's order - which
+			// routes wins, regardless of the selection order.
+			assert.NoError(t, ForwardMany(ctx, fsm, "c", "a", "b"))
+			assert.Equal(t, "b", fsm.CurrentQuestionID)
+
+			aswr, _ := fsm.Answers.Get("pick")
+			assert.Equal(t, []any{"A", "B", "C"}, aswr.Value())
+			assert.Len(t, aswr.Options, 3)
+
+			// Without routing options, the question's default.
+			assert.NoError(t, fsm.Backward())
+			assert.NoError(t, ForwardMany(ctx, fsm, "a", "d"))
+			assert.Equal(t, "c", fsm.CurrentQuestionID)
+
+			// Rules take precedence.
+			assert.NoError(t, fsm.Backward())
+			assert.NoError(t, ForwardMany(ctx, fsm, "e", "c", "a"))
+			assert.Equal(t, "e", fsm.CurrentQuestionID)
+
+			// Only multiple-select questions.
+			err = ForwardMany(ctx, fsm, done.GetID())
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardInvalidInput))
+
+			assert.NoError(t, Forward(ctx, fsm, done))
+
+			// Survives storage, and replays.
+			b2, err := shared.Marshal(fsm.GetJournal())
+			assert.NoError(t, err)
+
+			var journal []event.Event
+
+			assert.NoError(t, shared.Unmarshal(b2, &journal))
+
+			assert.Equal(t, []string{"a", "b", "c"}, journal[1].Transition.OptionIDs)
+
+			replayed, err := Replay(ctx, *q, journal)
+			assert.NoError(t, err)
+			assert.Equal(t, status.Completed, replayed.GetState())
+
+			aswr, _ = journal[len(journal)-1].Answers.Get("pick")
+			assert.Equal(t, []any{"A", "C", "E"}, aswr.Value())
+
+			// The options' order - so the routing, survives storage.
+			z := option.MustNew("Z", option.WithID("z"), option.WithNextQuestionID("b"))
+			y := option.MustNew("Y", option.WithID("y"), option.WithNextQuestionID("c"))
+
+			ordered, err := questionnaire.New(
+				"Simple Survey - 12",
+				question.MustNew[string]("pick", "Pick?", types.MultipleSelect, question.WithOption(z, y)),
+				q2,
+				q3,
+			)
+			assert.NoError(t, err)
+
+			b3, err := shared.Marshal(ordered)
+			assert.NoError(t, err)
+
+			var loaded questionnaire.Questionnaire
+
+			assert.NoError(t, shared.Unmarshal(b3, &loaded))
+
+			pick, _ := loaded.Questions.Get("pick")
+			assert.Equal(t, []string{"z", "y"}, pick.Options.Keys())
+
+			fsm, err = New(ctx, "12345", loaded, nil)
+			assert.NoError(t, err)
+
+			assert.NoError(t, fsm.Start())
+			assert.NoError(t, ForwardMany(ctx, fsm, "y", "z"))
+			assert.Equal(t, "b", fsm.CurrentQuestionID)
+		})
+	}
+}
+
+func TestForwardNumber(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "Should work",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			done := option.MustNew(true, option.WithState(status.Completed))
+
+			q1 := question.MustNew[int](
+				"age",
+				"Age?",
+				types.Integer,
+				question.WithRequired(true),
+				question.WithNumber(question.Range(0, 120)),
+				question.WithRule(
+					rule.GoTo("minor", rule.Between("age", 0, 18)...),
+					rule.GoTo("adult"),
+				),
+			)
+			q2 := question.MustNew[bool]("minor", "Guardian?", types.SingleSelect, question.WithOption(done))
+			q3 := question.MustNew[float64](
+				"adult",
+				"Weight?",
+				types.Decimal,
+				question.WithNumber(question.Number{HasMin: true, Min: 1, Step: 0.1, Unit: "kg"}),
+				question.WithState(status.Completed),
+			)
+
+			q, err := questionnaire.NewWithParams(
+				"Simple Survey - 14",
+				[]question.Question{q1, q2, q3},
+				questionnaire.WithValidation(true),
+			)
+			assert.NoError(t, err)
+
+			fsm, err := New(ctx, "12345", *q, nil)
+			assert.NoError(t, err)
+
+			assert.NoError(t, fsm.Start())
+
+			err = ForwardNumber(ctx, fsm, 17.5)
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerNumberInvalid))
+
+			err = ForwardNumber(ctx, fsm, 121)
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerNumberRange))
+
+			// Numeric ranges route.
+			assert.NoError(t, ForwardNumber(ctx, fsm, 17))
+			assert.Equal(t, "minor", fsm.CurrentQuestionID)
+
+			assert.NoError(t, fsm.Backward())
+			assert.NoError(t, ForwardNumber(ctx, fsm, 18))
+			assert.Equal(t, "adult", fsm.CurrentQuestionID)
+
+			err = ForwardNumber(ctx, fsm, 72.55)
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerNumberStep))
+
+			assert.NoError(t, ForwardNumber(ctx, fsm, 72.5))
+			assert.Equal(t, status.Completed, fsm.GetState())
+
+			aswr, ok := fsm.Answers.Get("adult")
+			assert.True(t, ok)
+			assert.Equal(t, 72.5, aswr.Value())
+
+			// Survives storage, and replays.
+			b, err := shared.Marshal(fsm.GetJournal())
+			assert.NoError(t, err)
+
+			var journal []event.Event
+
+			assert.NoError(t, shared.Unmarshal(b, &journal))
+
+			assert.Equal(t, "72.5", journal[len(journal)-1].Transition.Value)
+
+			replayed, err := Replay(ctx, *q, journal)
+			assert.NoError(t, err)
+
+			aswr, _ = replayed.Answers.Get("age")
+			assert.Equal(t, float64(18), aswr.Value())
+		})
+	}
+}
+
+func TestForwardDate(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "Should work",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			done := option.MustNew(true, option.WithState(status.Completed))
+
+			q1 := question.MustNew[string](
+				"birth",
+				"Birth date?",
+				types.Date,
+				question.WithRequired(true),
+				question.WithDate(question.Date{Location: "America/Sao_Paulo", Min: "1900-01-01", Max: "now"}),
+				question.WithRule(
+					rule.GoTo("guardian", rule.If("birth", rule.GreaterThan, "2000-01-01")),
+					rule.GoTo("appointment"),
+				),
+			)
+			q2 := question.MustNew[bool]("guardian", "Guardian?", types.SingleSelect, question.WithOption(done))
+			q3 := question.MustNew[string](
+				"appointment",
+				"Appointment?",
+				types.DateTime,
+				question.WithDate(question.Date{Location: "America/Sao_Paulo", Min: "now-1h"}),
+				question.WithNextQuestionID("vacation"),
+			)
+			q4 := question.MustNew[string](
+				"vacation",
+				"Vacation?",
+				types.DateRange,
+				question.WithState(status.Completed),
+			)
+
+			q, err := questionnaire.NewWithParams(
+				"Simple Survey - 15",
+				[]question.Question{q1, q2, q3, q4},
+				questionnaire.WithValidation(true),
+			)
+			assert.NoError(t, err)
+
+			fsm, err := New(ctx, "12345", *q, nil)
+			assert.NoError(t, err)
+
+			assert.NoError(t, fsm.Start())
+
+			err = ForwardDate(ctx, fsm, time.Now().AddDate(0, 0, 2))
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerDateRange))
+
+			// Dates are compared by rules.
+			assert.NoError(t, ForwardDate(ctx, fsm, time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC)))
+			assert.Equal(t, "guardian", fsm.CurrentQuestionID)
+
+			assert.NoError(t, fsm.Backward())
+			assert.NoError(t, ForwardDate(ctx, fsm, time.Date(1990, 5, 20, 0, 0, 0, 0, time.UTC)))
+			assert.Equal(t, "appointment", fsm.CurrentQuestionID)
+
+			aswr, ok := fsm.Answers.Get("birth")
+			assert.True(t, ok)
+			assert.Equal(t, "1990-05-20T00:00:00-03:00", aswr.Value().(time.Time).Format(time.RFC3339))
+
+			err = ForwardDate(ctx, fsm, time.Now().Add(-2*time.Hour))
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerDateRange))
+
+			appointment := time.Now().Add(24 * time.Hour).Truncate(time.Second).UTC()
+
+			assert.NoError(t, ForwardDate(ctx, fsm, appointment))
+			assert.Equal(t, "vacation", fsm.CurrentQuestionID)
+
+			err = ForwardDate(ctx, fsm, time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC))
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerDateInvalid))
+
+			assert.NoError(t, ForwardDate(ctx, fsm,
+				time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 7, 15, 0, 0, 0, 0, time.UTC),
+			))
+			assert.Equal(t, status.Completed, fsm.GetState())
+
+			// Survives storage - JSON, and BSON, and replays.
+			b, err := shared.Marshal(fsm.GetJournal())
+			assert.NoError(t, err)
+
+			var journal []event.Event
+
+			assert.NoError(t, shared.Unmarshal(b, &journal))
+
+			assert.Equal(t, "2024-07-01/2024-07-15", journal[len(journal)-1].Transition.Value)
+
+			aswr, _ = journal[len(journal)-1].Answers.Get("appointment")
+			assert.True(t, appointment.Equal(aswr.Value().(time.Time)))
+			assert.Equal(t, "America/Sao_Paulo", aswr.Value().(time.Time).Location().String())
+
+			aswr, _ = fsm.Answers.Get("vacation")
+
+			bb, err := shared.MarshalBSON(aswr)
+			assert.NoError(t, err)
+
+			var loaded answer.Answer
+
+			assert.NoError(t, shared.UnmarshalBSON(bb, &loaded))
+			assert.Equal(t, types.DateRange, loaded.Question.Type)
+			assert.Len(t, loaded.Dates, 2)
+			assert.True(t, aswr.Dates[1].Equal(loaded.Dates[1]))
+
+			replayed, err := Replay(ctx, *q, journal)
+			assert.NoError(t, err)
+
+			aswr, _ = replayed.Answers.Get("birth")
+			assert.Equal(t, "1990-05-20", question.FormatDate(types.Date, aswr.Dates...))
+		})
+	}
+}
+
+func TestForwardMatrix(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "Should work",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			done := option.MustNew(true, option.WithState(status.Completed))
+
+			q1 := question.MustNew[int](
+				"satisfaction",
+				"Rate each aspect",
+				types.Matrix,
+				question.WithOption(
+					option.MustNew(1, option.WithID("bad")),
+					option.MustNew(2, option.WithID("ok")),
+					option.MustNew(3, option.WithID("good")),
+				),
+				question.WithMatrix(question.Matrix{Rows: []question.Row{
+					{ID: "price", Label: "Price", Required: true},
+					{ID: "support", Label: "Support"},
+				}}),
+				question.WithNextQuestionID("channels"),
+				question.WithRule(rule.GoTo("complaint", rule.If("satisfaction.price", rule.Equal, 1))),
+			)
+			q2 := question.MustNew[bool]("complaint", "Tell us more?", types.SingleSelect, question.WithOption(done))
+			q3 := question.MustNew[string](
+				"channels",
+				"Which channels per product?",
+				types.Matrix,
+				question.WithOption(
+					option.MustNew("email", option.WithID("email")),
+					option.MustNew("phone", option.WithID("phone")),
+					option.MustNew("chat", option.WithID("chat")),
+				),
+				question.WithMatrix(question.Matrix{Multiple: true, Rows: []question.Row{{ID: "a"}, {ID: "b"}}}),
+				question.WithSelection(question.Selection{Max: 2}),
+				question.WithState(status.Completed),
+			)
+
+			q, err := questionnaire.NewWithParams(
+				"Simple Survey - 16",
+				[]question.Question{q1, q2, q3},
+				questionnaire.WithValidation(true),
+			)
+			assert.NoError(t, err)
+
+			fsm, err := New(ctx, "12345", *q, nil)
+			assert.NoError(t, err)
+
+			assert.NoError(t, fsm.Start())
+
+			err = ForwardMatrix(ctx, fsm, map[string][]string{"support": {"good"}})
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerRowRequired))
+
+			err = ForwardMatrix(ctx, fsm, map[string][]string{"price": {"ok", "good"}})
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerSelectionCount))
+
+			err = ForwardMatrix(ctx, fsm, map[string][]string{"price": {"ok"}, "speed": {"ok"}})
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardInvalidRow))
+
+			err = ForwardMatrix(ctx, fsm, map[string][]string{"price": {"great"}})
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardInvalidOption))
+
+			// Rows are referenced by rules.
+			assert.NoError(t, ForwardMatrix(ctx, fsm, map[string][]string{"price": {"bad"}}))
+			assert.Equal(t, "complaint", fsm.CurrentQuestionID)
+
+			// The whole grid is one step.
+			assert.NoError(t, fsm.Backward())
+			assert.NoError(t, ForwardMatrix(ctx, fsm, map[string][]string{"price": {"good"}, "support": {"ok"}}))
+			assert.Equal(t, "channels", fsm.CurrentQuestionID)
+
+			aswr, ok := fsm.Answers.Get("satisfaction")
+			assert.True(t, ok)
+			assert.Equal(t, map[string]any{"price": 3, "support": 2}, aswr.Value())
+
+			err = ForwardMatrix(ctx, fsm, map[string][]string{"a": {"email", "phone", "chat"}})
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerSelectionCount))
+
+			assert.NoError(t, ForwardMatrix(ctx, fsm, map[string][]string{"a": {"chat", "email"}, "b": {"phone"}}))
+			assert.Equal(t, status.Completed, fsm.GetState())
+
+			aswr, _ = fsm.Answers.Get("channels")
+			assert.Equal(t, map[string]any{"a": []any{"email", "chat"}, "b": []any{"phone"}}, aswr.Value())
+
+			// Survives storage, and replays.
+			b, err := shared.Marshal(fsm.GetJournal())
+			assert.NoError(t, err)
+
+			var journal []event.Event
+
+			assert.NoError(t, shared.Unmarshal(b, &journal))
+
+			assert.Equal(t, []string{"email", "chat"}, journal[len(journal)-1].Transition.RowOptionIDs["a"])
+
+			replayed, err := Replay(ctx, *q, journal)
+			assert.NoError(t, err)
+
+			aswr, _ = replayed.Answers.Get("satisfaction")
+			assert.Equal(t, map[string]any{"price": 3, "support": 2}, aswr.Value())
+		})
+	}
+}
+
+func TestForwardRanking(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "Should work",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			done := option.MustNew(true, option.WithState(status.Completed))
+
+			q1 := question.MustNew[string](
+				"languages",
+				"Rank the languages",
+				types.Ranking,
+				question.WithRequired(true),
+				question.WithOption(
+					option.MustNew("Go", option.WithID("go")),
+					option.MustNew("Rust", option.WithID("rust")),
+					option.MustNew("Zig", option.WithID("zig")),
+				),
+				question.WithRule(rule.GoTo("gopher", rule.If("languages", rule.Equal, []string{"Go", "Zig", "Rust"}))),
+				question.WithNextQuestionID("top"),
+			)
+			q2 := question.MustNew[bool]("gopher", "Gopher?", types.SingleSelect, question.WithOption(done))
+			q3 := question.MustNew[string](
+				"top",
+				"Your top 2",
+				types.Ranking,
+				question.WithOption(
+					option.MustNew("Go", option.WithID("go")),
+					option.MustNew("Rust", option.WithID("rust")),
+					option.MustNew("Zig", option.WithID("zig")),
+				),
+				question.WithRanking(question.Ranking{Top: 2}),
+				question.WithState(status.Completed),
+			)
+
+			q, err := questionnaire.NewWithParams(
+				"Simple Survey - 17",
+				[]question.Question{q1, q2, q3},
+				questionnaire.WithValidation(true),
+			)
+			assert.NoError(t, err)
+
+			fsm, err := New(ctx, "12345", *q, nil)
+			assert.NoError(t, err)
+
+			assert.NoError(t, fsm.Start())
+
+			err = ForwardRanking(ctx, fsm)
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerOptionRequired))
+
+			err = ForwardRanking(ctx, fsm, "go", "go", "zig")
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerRankingDuplicate))
+
+			err = ForwardRanking(ctx, fsm, "go", "zig")
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerRankingIncomplete))
+
+			err = ForwardRanking(ctx, fsm, "go", "zig", "java")
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardInvalidOption))
+
+			// Rules are evaluated against the ordered values.
+			assert.NoError(t, ForwardRanking(ctx, fsm, "go", "zig", "rust"))
+			assert.Equal(t, "gopher", fsm.CurrentQuestionID)
+
+			assert.NoError(t, fsm.Backward())
+			assert.NoError(t, ForwardRanking(ctx, fsm, "rust", "go", "zig"))
+			assert.Equal(t, "top", fsm.CurrentQuestionID)
+
+			aswr, ok := fsm.Answers.Get("languages")
+			assert.True(t, ok)
+			assert.Equal(t, []string{"rust", "go", "zig"}, aswr.Ranking)
+			assert.Equal(t, []any{"Rust", "Go", "Zig"}, aswr.Value())
+
+			assert.NoError(t, ForwardRanking(ctx, fsm, "zig", "go"))
+			assert.Equal(t, status.Completed, fsm.GetState())
+
+			// Survives storage, and replays.
+			b, err := shared.Marshal(fsm.GetJournal())
+			assert.NoError(t, err)
+
+			var journal []event.Event
+
+			assert.NoError(t, shared.Unmarshal(b, &journal))
+
+			replayed, err := Replay(ctx, *q, journal)
+			assert.NoError(t, err)
+
+			aswr, _ = replayed.Answers.Get("top")
+			assert.Equal(t, []string{"zig", "go"}, aswr.Ranking)
+			assert.Equal(t, map[string]int{"zig": 3, "go": 2}, question.Ranking{}.Points(aswr.Ranking, 3))
+		})
+	}
+}
+
+func TestForwardAttachment(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "Should work",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			q1 := question.MustNew[string](
+				"document",
+				"Upload your ID document",
+				types.Attachment,
+				question.WithRequired(true),
+				question.WithAttachment(question.Attachment{MaxSize: 16, MIMETypes: []string{"text/*"}}),
+				question.WithState(status.Completed),
+			)
+
+			q, err := questionnaire.NewWithParams(
+				"Simple Survey - 18",
+				[]question.Question{q1},
+				questionnaire.WithValidation(true),
+			)
+			assert.NoError(t, err)
+
+			fsm, err := New(ctx, "12345", *q, nil)
+			assert.NoError(t, err)
+
+			assert.NoError(t, fsm.Start())
+
+			err = ForwardAttachment(ctx, fsm, "id.txt", strings.NewReader("ID 123"))
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrFSMBlobStoreMissing))
+
+			dir := t.TempDir()
+
+			blobStore, err := blob.NewFile(dir)
+			assert.NoError(t, err)
+
+			fsm.SetBlobStore(blobStore)
+
+			err = ForwardAttachment(ctx, fsm, "id.txt", strings.NewReader("a very long ID document"))
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerAttachmentSize))
+
+			err = ForwardAttachment(ctx, fsm, "id.png", bytes.NewReader([]byte("\x89PNG\x0D\x0A\x1A\x0A")))
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerAttachmentType))
+
+			// Files not accepted are deleted.
+			entries, err := os.ReadDir(dir)
+			assert.NoError(t, err)
+			assert.Empty(t, entries)
+
+			assert.NoError(t, ForwardAttachment(ctx, fsm, "id.txt", strings.NewReader("ID 123")))
+			assert.Equal(t, status.Completed, fsm.GetState())
+
+			r, m, err := fsm.GetAttachment(ctx, "document")
+			assert.NoError(t, err)
+
+			content, err := io.ReadAll(r)
+			assert.NoError(t, err)
+			assert.NoError(t, r.Close())
+
+			assert.Equal(t, "ID 123", string(content))
+			assert.Equal(t, "id.txt", m.Name)
+			assert.Equal(t, int64(6), m.Size)
+			assert.Equal(t, "text/plain", m.MIMEType)
+
+			// Survives storage, and replays - without storing the file again.
+			b, err := shared.Marshal(fsm.GetJournal())
+			assert.NoError(t, err)
+
+			var journal []event.Event
+
+			assert.NoError(t, shared.Unmarshal(b, &journal))
+
+			replayed, err := Replay(ctx, *q, journal)
+			assert.NoError(t, err)
+
+			replayed.SetBlobStore(blobStore)
+
+			r, replayedM, err := replayed.GetAttachment(ctx, "document")
+			assert.NoError(t, err)
+			assert.NoError(t, r.Close())
+			assert.Equal(t, m, replayedM)
+
+			// Re-answering replaces the file.
+			assert.NoError(t, fsm.Jump("document"))
+			assert.NoError(t, ForwardAttachment(ctx, fsm, "passport.txt", strings.NewReader("PP 456")))
+
+			r, newM, err := fsm.GetAttachment(ctx, "document")
+			assert.NoError(t, err)
+			assert.NoError(t, r.Close())
+			assert.NotEqual(t, m.Key, newM.Key)
+
+			entries, err = os.ReadDir(dir)
+			assert.NoError(t, err)
+			assert.Len(t, entries, 1)
+			assert.Equal(t, newM.Key, entries[0].Name())
+		})
+	}
+}
+
+func TestForwardText_validators(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "Should work",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			q1 := question.MustNew[string](
+				"email",
+				"What's your email?",
+				types.Text,
+				question.WithRequired(true),
+				question.WithValidator(
+					validator.Use(validator.Email),
+					validator.Ref{Arg: `@example\.com$`, Message: "must be a company email", Name: validator.Regex},
+				),
+				question.WithNextQuestionID("phone"),
+			)
+
+			q2 := question.MustNew[string](
+				"phone",
+				"What's your phone?",
+				types.Text,
+				question.WithValidator(validator.Use(validator.Phone)),
+				question.WithState(status.Completed),
+			)
+
+			q, err := questionnaire.NewWithParams(
+				"Simple Survey - 19",
+				[]question.Question{q1, q2},
+				questionnaire.WithValidation(true),
+			)
+			assert.NoError(t, err)
+
+			fsm, err := New(ctx, "12345", *q, nil)
+			assert.NoError(t, err)
+
+			assert.NoError(t, fsm.Start())
+
+			// Presence of required questions isn't up to validators.
+			err = ForwardText(ctx, fsm, "")
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerOptionRequired))
+
+			err = ForwardText(ctx, fsm, "john.example.com")
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerValidation))
+
+			var errs validator.Errors
+
+			assert.True(t, errors.As(err, &errs))
+			assert.Equal(t, validator.Errors{
+				{Message: "invalid email", QuestionID: "email", Validator: validator.Email},
+				{Message: "must be a company email", QuestionID: "email", Validator: validator.Regex},
+			}, errs)
+
+			assert.NoError(t, ForwardText(ctx, fsm, "john@example.com"))
+
+			err = ForwardText(ctx, fsm, "11 99999-9999")
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerValidation))
+
+			// Optional questions can be left blank, regardless of validators.
+			assert.NoError(t, ForwardText(ctx, fsm, ""))
+			assert.Equal(t, status.Completed, fsm.GetState())
+
+			assert.NoError(t, ForwardText(ctx, fsm, "+5511999999999"))
+			assert.Equal(t, status.Completed, fsm.GetState())
+		})
+	}
+}
+
+func TestForwardPage(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "Should work",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			q1 := question.MustNew[string](
+				"name",
+				"What's your name?",
+				types.Text,
+				question.WithRequired(true),
+			)
+
+			q2 := question.MustNew[string](
+				"age",
+				"How old are you?",
+				types.Integer,
+				question.WithNextQuestionID("color"),
+				question.WithRule(rule.SetState(status.Completed, rule.If("age", rule.LessThan, 18))),
+			)
+
+			q3 := question.MustNew[bool](
+				"newsletter",
+				"Subscribe to the newsletter?",
+				types.SingleSelect,
+				question.WithOption(option.MustNew(true, option.WithID("yes"))),
+			)
+
+			red := option.MustNew("Red", option.WithState(status.Completed))
+
+			q4 := question.MustNew[string](
+				"color",
+				"What's your favorite color?",
+				types.SingleSelect,
+				question.WithOption(red),
+			)
+
+			q, err := questionnaire.NewWithParams(
+				"Simple Survey - 20",
+				[]question.Question{q1, q2, q3, q4},
+				questionnaire.WithPage(questionnaire.Page{
+					ID:          "about",
+					QuestionIDs: []string{"name", "newsletter", "age"},
+					Title:       "About you",
+				}),
+				questionnaire.WithValidation(true),
+			)
+			assert.NoError(t, err)
+
+			fsm, err := New(ctx, "12345", *q, nil)
+			assert.NoError(t, err)
+
+			fsm.SetJournalMode(JournalCompact)
+
+			assert.NoError(t, fsm.Start())
+
+			e := fsm.Dump()
+			assert.Equal(t, "about", e.CurrentPage.ID)
+			assert.Len(t, e.CurrentPageQuestions, 3)
+			assert.Equal(t, "newsletter", e.CurrentPageQuestions[1].GetID())
+
+			// Paged questions are answered by page.
+			err = ForwardText(ctx, fsm, "John")
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardPaged))
+
+			err = Skip(ctx, fsm)
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardPaged))
+
+			err = ForwardPage(ctx, fsm, event.Transition{QuestionID: "color", OptionID: red.GetID()})
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardInvalidPage))
+
+			// Validated as a unit: nothing is recorded.
+			err = ForwardPage(ctx, fsm,
+				event.Transition{QuestionID: "name", Value: "John"},
+				event.Transition{QuestionID: "age", Value: "thirty"},
+			)
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerNumberInvalid))
+
+			err = ForwardPage(ctx, fsm, event.Transition{QuestionID: "age", Value: "30"})
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrSkipRequired))
+
+			assert.Equal(t, 0, fsm.Answers.Size())
+
+			// Questions without a response are skipped.
+			assert.NoError(t, ForwardPage(ctx, fsm,
+				event.Transition{QuestionID: "age", Value: "30"},
+				event.Transition{QuestionID: "name", Value: "John"},
+			))
+
+			assert.Equal(t, "color", fsm.CurrentQuestionID)
+			assert.Equal(t, 3, fsm.Answers.Size())
+
+			aswr, ok := fsm.Answers.Get("newsletter")
+			assert.True(t, ok)
+			assert.True(t, aswr.IsSkipped())
+
+			e = fsm.Dump()
+			assert.Empty(t, e.CurrentPage.ID)
+			assert.Equal(t, "name", e.PreviousQuestion.GetID())
+
+			// Goes back to the page.
+			assert.NoError(t, fsm.Backward())
+			assert.Equal(t, "about", fsm.Dump().CurrentPage.ID)
+
+			// Branching happens at page boundaries - rules see all answers.
+			assert.NoError(t, ForwardPage(ctx, fsm,
+				event.Transition{QuestionID: "name", Value: "Johnny"},
+				event.Transition{QuestionID: "newsletter", OptionID: "yes"},
+				event.Transition{QuestionID: "age", Value: "12"},
+			))
+			assert.Equal(t, status.Completed, fsm.GetState())
+
+			journal := fsm.GetJournal()
+
+			last := journal[len(journal)-1]
+			assert.Equal(t, "about", last.Transition.PageID)
+			assert.Len(t, last.Transition.Responses, 3)
+
+			// Survives storage, and replays.
+			b, err := shared.Marshal(fsm.GetCompactJournal())
+			assert.NoError(t, err)
+
+			var loaded event.CompactJournal
+
+			assert.NoError(t, shared.Unmarshal(b, &loaded))
+
+			journal, err = loaded.Materialize()
+			assert.NoError(t, err)
+
+			replayed, err := Replay(ctx, *q, journal)
+			assert.NoError(t, err)
+			assert.Equal(t, status.Completed, replayed.GetState())
+
+			aswr, _ = replayed.Answers.Get("name")
+			assert.Equal(t, "Johnny", aswr.Value())
+		})
+	}
+}
+
+func TestForwardPage_attachment(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "Should work",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			q1 := question.MustNew[string]("name", "What's your name?", types.Text)
+
+			q2 := question.MustNew[string](
+				"document",
+				"Upload your ID document",
+				types.Attachment,
+				question.WithRequired(true),
+				question.WithAttachment(question.Attachment{MaxSize: 16, MIMETypes: []string{"text/*"}}),
+				question.WithState(status.Completed),
+			)
+
+			q, err := questionnaire.NewWithParams(
+				"Simple Survey - 20",
+				[]question.Question{q1, q2},
+				questionnaire.WithPage(questionnaire.Page{ID: "about", QuestionIDs: []string{"name", "document"}}),
+				questionnaire.WithValidation(true),
+			)
+			assert.NoError(t, err)
+
+			fsm, err := New(ctx, "12345", *q, nil)
+			assert.NoError(t, err)
+
+			assert.NoError(t, fsm.Start())
+
+			blobStore, err := blob.NewFile(t.TempDir())
+			assert.NoError(t, err)
+
+			png, err := blobStore.Put(ctx, "id.png", bytes.NewReader([]byte("\x89PNG\x0D\x0A\x1A\x0A")))
+			assert.NoError(t, err)
+
+			// Claimed metadata isn't trusted.
+			claimed := png
+			claimed.MIMEType = "text/plain"
+
+			err = ForwardPage(ctx, fsm, event.Transition{QuestionID: "document", Attachment: &claimed})
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrFSMBlobStoreMissing))
+
+			fsm.SetBlobStore(blobStore)
+
+			err = ForwardPage(ctx, fsm, event.Transition{QuestionID: "document", Attachment: &claimed})
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerAttachmentType))
+
+			unknown := blob.Metadata{Key: "unknown", Name: "id.txt", MIMEType: "text/plain", Size: 6}
+
+			err = ForwardPage(ctx, fsm, event.Transition{QuestionID: "document", Attachment: &unknown})
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrBlobNotFound))
+
+			m, err := blobStore.Put(ctx, "id.txt", strings.NewReader("ID 123"))
+			assert.NoError(t, err)
+
+			assert.NoError(t, ForwardPage(ctx, fsm, event.Transition{
+				QuestionID: "document",
+				Attachment: &blob.Metadata{Key: m.Key, Name: m.Name},
+			}))
+			assert.Equal(t, status.Completed, fsm.GetState())
+
+			r, recorded, err := fsm.GetAttachment(ctx, "document")
+			assert.NoError(t, err)
+			assert.NoError(t, r.Close())
+			assert.Equal(t, m, recorded)
+		})
+	}
+}
+
+func TestSetScoring(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "Should work",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			q1 := question.MustNewScale(
+				"interest",
+				"Little interest or pleasure in doing things",
+				types.Likert,
+				scoring.Frequency(),
+				question.WithNextQuestionID("mood"),
+			)
+
+			q2 := question.MustNewScale(
+				"mood",
+				"Feeling down, depressed, or hopeless",
+				types.Likert,
+				scoring.Frequency(),
+				question.WithState(status.Completed),
+			)
+
+			q, err := questionnaire.NewWithParams(
+				"Simple Survey - 21",
+				[]question.Question{q1, q2},
+				questionnaire.WithValidation(true),
+			)
+			assert.NoError(t, err)
+
+			scores := []scoring.Score{}
+
+			fsm, err := New(ctx, "12345", *q, func(e event.Event, _ []event.Event) {
+				scores = append(scores, e.Score)
+			})
+			assert.NoError(t, err)
+
+			fsm.SetScoring(scoring.Bands("phq-2", scoring.Sum,
+				scoring.Band{Label: "negative", Min: 0, Max: 2},
+				scoring.Band{Label: "positive", Min: 3, Max: 6},
+			))
+
+			assert.NoError(t, fsm.Start())
+
+			several, err := question.GetOption[int](q1, "1")
+			assert.NoError(t, err)
+
+			assert.NoError(t, Forward(ctx, fsm, several))
+
+			nearly, err := question.GetOption[int](q2, "3")
+			assert.NoError(t, err)
+
+			assert.NoError(t, Forward(ctx, fsm, nearly))
+			assert.Equal(t, status.Completed, fsm.GetState())
+
+			// Running score, on every event.
+			assert.Len(t, scores, 3)
+			assert.Equal(t, float64(0), scores[0].Total)
+			assert.Equal(t, float64(1), scores[1].Total)
+			assert.Equal(t, "negative", scores[1].Band)
+			assert.Equal(t, float64(4), scores[2].Total)
+			assert.Equal(t, float64(6), scores[2].Max)
+			assert.Equal(t, "positive", scores[2].Band)
+			assert.Equal(t, "phq-2", scores[2].Strategy)
+
+			// Scores are kept by compact journals.
+			compact := event.CompactJournal{}
+
+			for _, e := range fsm.GetJournal() {
+				compact.Add(e)
+			}
+
+			journal, err := compact.Materialize()
+			assert.NoError(t, err)
+			assert.Equal(t, scores[2], journal[2].Score)
+		})
+	}
+}
+
+func TestForward_quiz(t *testing.T) {
+	tests := []struct {
+		name     string
+		capital  string
+		primes   []string
+		want     status.Status
+		feedback []string
+	}{
+		{
+			name:     "Should work",
+			capital:  "paris",
+			primes:   []string{"2", "3", "5"},
+			want:     status.Succeeded,
+			feedback: []string{"Right!"},
+		},
+		{
+			name:     "Should work - failed",
+			capital:  "rome",
+			primes:   []string{"2", "3"},
+			want:     status.Failed,
+			feedback: []string{"Rome is Italy's capital."},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			q1 := question.MustNew[string]("capital", "Capital of France", types.SingleSelect,
+				question.WithOption(
+					option.MustNew("paris", option.WithID("paris"), option.WithCorrect(true), option.WithFeedback("Right!"), option.WithNextQuestionID("primes")),
+					option.MustNew("rome", option.WithID("rome"), option.WithFeedback("Rome is Italy's capital."), option.WithNextQuestionID("primes")),
+				),
+			)
+
+			q2 := question.MustNew[int]("primes", "Primes", types.MultipleSelect,
+				question.WithOption(
+					option.MustNew(2, option.WithID("2"), option.WithCorrect(true)),
+					option.MustNew(3, option.WithID("3"), option.WithCorrect(true)),
+					option.MustNew(4, option.WithID("4")),
+					option.MustNew(5, option.WithID("5"), option.WithCorrect(true)),
+				),
+				question.WithState(status.Completed),
+			)
+
+			q, err := questionnaire.NewWithParams(
+				"Simple Survey - 22",
+				[]question.Question{q1, q2},
+				questionnaire.WithQuiz(questionnaire.Quiz{Pass: 70}),
+				questionnaire.WithValidation(true),
+			)
+			assert.NoError(t, err)
+
+			fsm, err := New(ctx, "12345", *q, nil)
+			assert.NoError(t, err)
+
+			assert.NoError(t, fsm.Start())
+
+			opt, err := question.GetOption[string](q1, tt.capital)
+			assert.NoError(t, err)
+
+			assert.NoError(t, Forward(ctx, fsm, opt))
+
+			// Feedback is shown after answering.
+			forwarded := fsm.GetJournal()
+
+			assert.Equal(t, map[string][]string{"capital": tt.feedback}, forwarded[len(forwarded)-1].Feedback())
+
+			assert.NoError(t, ForwardMany(ctx, fsm, tt.primes...))
+
+			// The pass threshold determines the final state.
+			assert.Equal(t, tt.want, fsm.GetState())
+
+			journal := fsm.GetJournal()
+
+			assert.Equal(t, "quiz", journal[len(journal)-1].Score.Strategy)
+
+			replayed, err := Replay(ctx, *q, journal)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, replayed.GetState())
+
+			// Graded sessions are finished, they can't be revised.
+			finished := errorcatalog.Catalog.MustGet(errorcatalog.ErrFSMAlreadyFinished)
+
+			assert.ErrorIs(t, fsm.Jump("capital"), finished)
+			assert.ErrorIs(t, fsm.Done(), finished)
+			assert.Equal(t, tt.want, fsm.GetState())
+
+			// Sessions done before all questions are answered are graded too.
+			early, err := New(ctx, "12345", *q, nil)
+			assert.NoError(t, err)
+
+			assert.NoError(t, early.Start())
+			assert.NoError(t, Forward(ctx, early, opt))
+			assert.NoError(t, early.Done())
+			assert.Equal(t, status.Failed, early.GetState())
+		})
+	}
+}
+
+func TestDone_quiz(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "Should work",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			yes := option.MustNew("yes", option.WithID("yes"), option.WithCorrect(true), option.WithState(status.Done))
+
+			q1 := question.MustNew[string]("ready", "Ready?", types.SingleSelect, question.WithOption(yes))
+
+			q, err := questionnaire.NewWithParams(
+				"Simple Survey - 22 - done",
+				[]question.Question{q1},
+				questionnaire.WithQuiz(questionnaire.Quiz{Pass: 70}),
+			)
+			assert.NoError(t, err)
+
+			fsm, err := New(ctx, "12345", *q, nil)
+			assert.NoError(t, err)
+
+			assert.NoError(t, fsm.Start())
+
+			// Options finishing the session grade it.
+			assert.NoError(t, Forward(ctx, fsm, yes))
+			assert.Equal(t, status.Succeeded, fsm.GetState())
+		})
+	}
+}
+
+func TestForward_outcome(t *testing.T) {
+	tests := []struct {
+		name       string
+		experience string
+		language   string
+		want       string
+	}{
+		{
+			name:       "Should work",
+			experience: "10+",
+			language:   "go",
+			want:       "expert",
+		},
+		{
+			name:       "Should work - specific answer",
+			experience: "0-2",
+			language:   "rust",
+			want:       "curious",
+		},
+		{
+			name:       "Should work - fallback",
+			experience: "0-2",
+			language:   "go",
+			want:       "beginner",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			q1 := question.MustNew[string]("experience", "Years of experience", types.SingleSelect,
+				question.WithOption(
+					option.MustNew("0-2", option.WithID("0-2"), option.WithWeight(0), option.WithNextQuestionID("language")),
+					option.MustNew("10+", option.WithID("10+"), option.WithWeight(10), option.WithNextQuestionID("language")),
+				),
+			)
+
+			q2 := question.MustNew[string]("language", "Favorite language", types.SingleSelect,
+				question.WithOption(
+					option.MustNew("go", option.WithID("go"), option.WithWeight(1), option.WithState(status.Completed)),
+					option.MustNew("rust", option.WithID("rust"), option.WithWeight(1), option.WithState(status.Completed)),
+				),
+			)
+
+			q, err := questionnaire.NewWithParams(
+				"Simple Survey - 23",
+				[]question.Question{q1, q2},
+				questionnaire.WithOutcome(
+					questionnaire.Outcome{
+						ID:         "expert",
+						Label:      "Expert",
+						Conditions: []rule.Condition{rule.If(questionnaire.ScoreTotal, rule.GreaterThanOrEqual, 10)},
+					},
+					questionnaire.Outcome{
+						ID:         "curious",
+						Label:      "Curious",
+						Conditions: []rule.Condition{rule.If("language", rule.Equal, "rust")},
+					},
+					questionnaire.Outcome{ID: "beginner", Label: "Beginner"},
+				),
+				questionnaire.WithValidation(true),
+			)
+			assert.NoError(t, err)
+
+			outcomes := []*questionnaire.Outcome{}
+
+			fsm, err := New(ctx, "12345", *q, func(e event.Event, _ []event.Event) {
+				outcomes = append(outcomes, e.Outcome)
+			})
+			assert.NoError(t, err)
+
+			assert.NoError(t, fsm.Start())
+
+			experience, err := question.GetOption[string](q1, tt.experience)
+			assert.NoError(t, err)
+
+			assert.NoError(t, Forward(ctx, fsm, experience))
+
+			language, err := question.GetOption[string](q2, tt.language)
+			assert.NoError(t, err)
+
+			assert.NoError(t, Forward(ctx, fsm, language))
+			assert.Equal(t, status.Completed, fsm.GetState())
+
+			// Only the final event carries the outcome.
+			assert.Len(t, outcomes, 3)
+			assert.Nil(t, outcomes[1])
+			assert.NotNil(t, outcomes[2])
+			assert.Equal(t, tt.want, outcomes[2].ID)
+
+			// Outcomes are kept by compact journals.
+			compact := event.CompactJournal{}
+
+			for _, e := range fsm.GetJournal() {
+				compact.Add(e)
+			}
+
+			journal, err := compact.Materialize()
+			assert.NoError(t, err)
+			assert.Equal(t, outcomes[2], journal[2].Outcome)
+		})
+	}
+}
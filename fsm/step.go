@@ -0,0 +1,418 @@
+package fsm
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/thalesfsp/customerror"
+	"github.com/thalesfsp/questionnaire/answer"
+	"github.com/thalesfsp/questionnaire/blob"
+	"github.com/thalesfsp/questionnaire/errorcatalog"
+	"github.com/thalesfsp/questionnaire/event"
+	"github.com/thalesfsp/questionnaire/option"
+	"github.com/thalesfsp/questionnaire/question"
+	"github.com/thalesfsp/questionnaire/types"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// step is the answer to a question, where it routes the machine to, and the
+// transition recording it. Building it doesn't change the machine.
+type step struct {
+	qst        question.Question
+	aswr       answer.Answer
+	route      route
+	transition event.Transition
+}
+
+//////
+// Helpers.
+//////
+
+// respond answers, or skips the question `qst` as the transition `t` - a
+// recorded one, or a page's response, describes.
+func respond(qst question.Question, t event.Transition) (step, error) {
+	if t.Type == event.TransitionSkipped {
+		return stepSkipped(qst)
+	}
+
+	switch {
+	case t.OptionID != "":
+		var (
+			raw any
+			ok  bool
+		)
+
+		if qst.Options != nil {
+			raw, ok = qst.Options.Get(t.OptionID)
+		}
+
+		if !ok {
+			return step{}, customerror.Wrap(
+				errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardInvalidOption),
+				fmt.Errorf("option %q", t.OptionID),
+			)
+		}
+
+		opt, err := option.ToOption[any](raw)
+		if err != nil {
+			return step{}, err
+		}
+
+		return stepOption(qst, t.OptionID, raw, route{
+			nextQuestionID: opt.NextQuestionID(),
+			rules:          opt.GetRules(),
+			state:          opt.GetState(),
+		})
+	case qst.Type == types.Attachment && t.Attachment != nil:
+		// Files are already stored. Their metadata is read from the store
+		// by pages, and trusted by replays.
+		return stepAttachment(qst, *t.Attachment)
+	case qst.Type == types.MultipleSelect:
+		return stepMany(qst, t.OptionIDs)
+	case qst.Type == types.Matrix:
+		return stepMatrix(qst, t.RowOptionIDs)
+	case qst.Type == types.Ranking:
+		return stepRanking(qst, t.OptionIDs)
+	}
+
+	return stepInput(qst, t.Value)
+}
+
+// stepOption answers the question `qst` with the option `opt` (`optID`) -
+// already trusted, routing through `r`.
+func stepOption(qst question.Question, optID string, opt any, r route) (step, error) {
+	aswr, err := answer.New(qst, opt)
+	if err != nil {
+		return step{}, err
+	}
+
+	return step{
+		qst:   qst,
+		aswr:  aswr,
+		route: r,
+		transition: event.Transition{
+			Type:       event.TransitionForwarded,
+			QuestionID: qst.GetID(),
+			OptionID:   optID,
+		},
+	}, nil
+}
+
+// stepMany answers the question `qst` selecting the options `optionIDs`.
+func stepMany(qst question.Question, optionIDs []string) (step, error) {
+	if qst.Type != types.MultipleSelect {
+		return step{}, customerror.Wrap(
+			errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardInvalidInput),
+			fmt.Errorf("question %s is of the %s type", qst.GetID(), qst.Type),
+		)
+	}
+
+	// Selected options must be distinct options of the question.
+	selected := map[string]bool{}
+
+	for _, id := range optionIDs {
+		if selected[id] || qst.Options == nil || !qst.Options.Contains(id) {
+			return step{}, customerror.Wrap(
+				errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardInvalidOption),
+				fmt.Errorf("option %q", id),
+			)
+		}
+
+		selected[id] = true
+	}
+
+	// Selected options, and branching follow the question's options order.
+	opts := []any{}
+	ids := []string{}
+
+	r := route{}
+
+	if qst.Options != nil {
+		for _, id := range qst.Options.Keys() {
+			if !selected[id] {
+				continue
+			}
+
+			raw, _ := qst.Options.Get(id)
+
+			opts = append(opts, raw)
+			ids = append(ids, id)
+
+			opt, err := option.ToOption[any](raw)
+			if err != nil {
+				return step{}, err
+			}
+
+			r.rules = append(r.rules, opt.GetRules()...)
+
+			if r.nextQuestionID == "" && !isState(r.state) {
+				r.nextQuestionID = opt.NextQuestionID()
+				r.state = opt.GetState()
+			}
+		}
+	}
+
+	if r.nextQuestionID == "" && !isState(r.state) {
+		r.nextQuestionID = qst.Meta.NextQuestionID
+		r.state = qst.Meta.State
+	}
+
+	aswr, err := answer.NewMany(qst, opts)
+	if err != nil {
+		return step{}, err
+	}
+
+	return step{
+		qst:   qst,
+		aswr:  aswr,
+		route: r,
+		transition: event.Transition{
+			Type:       event.TransitionForwarded,
+			QuestionID: qst.GetID(),
+			OptionIDs:  ids,
+		},
+	}, nil
+}
+
+// stepMatrix answers the matrix question `qst` selecting the options per row
+// ID (`rows`).
+func stepMatrix(qst question.Question, rows map[string][]string) (step, error) {
+	if qst.Type != types.Matrix || qst.Meta.Matrix == nil {
+		return step{}, customerror.Wrap(
+			errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardInvalidInput),
+			fmt.Errorf("question %s is of the %s type", qst.GetID(), qst.Type),
+		)
+	}
+
+	cells := make(map[string][]any, len(rows))
+	ids := make(map[string][]string, len(rows))
+
+	for row, optionIDs := range rows {
+		if _, ok := qst.Meta.Matrix.Row(row); !ok {
+			return step{}, customerror.Wrap(
+				errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardInvalidRow),
+				fmt.Errorf("row %q", row),
+			)
+		}
+
+		// Selected options must be distinct options of the question.
+		selected := map[string]bool{}
+
+		for _, id := range optionIDs {
+			if selected[id] || qst.Options == nil || !qst.Options.Contains(id) {
+				return step{}, customerror.Wrap(
+					errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardInvalidOption),
+					fmt.Errorf("row %q, option %q", row, id),
+				)
+			}
+
+			selected[id] = true
+		}
+
+		if qst.Options == nil {
+			continue
+		}
+
+		// Selected options follow the question's options order.
+		for _, id := range qst.Options.Keys() {
+			if !selected[id] {
+				continue
+			}
+
+			raw, _ := qst.Options.Get(id)
+
+			cells[row] = append(cells[row], raw)
+			ids[row] = append(ids[row], id)
+		}
+	}
+
+	aswr, err := answer.NewMatrix(qst, cells)
+	if err != nil {
+		return step{}, err
+	}
+
+	return step{
+		qst:  qst,
+		aswr: aswr,
+		route: route{
+			nextQuestionID: qst.Meta.NextQuestionID,
+			state:          qst.Meta.State,
+		},
+		transition: event.Transition{
+			Type:         event.TransitionForwarded,
+			QuestionID:   qst.GetID(),
+			RowOptionIDs: ids,
+		},
+	}, nil
+}
+
+// stepRanking answers the ranking question `qst` with the options
+// `optionIDs`, in order.
+func stepRanking(qst question.Question, optionIDs []string) (step, error) {
+	if qst.Type != types.Ranking {
+		return step{}, customerror.Wrap(
+			errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardInvalidInput),
+			fmt.Errorf("question %s is of the %s type", qst.GetID(), qst.Type),
+		)
+	}
+
+	opts := make([]any, 0, len(optionIDs))
+
+	for _, id := range optionIDs {
+		var (
+			raw any
+			ok  bool
+		)
+
+		if qst.Options != nil {
+			raw, ok = qst.Options.Get(id)
+		}
+
+		if !ok {
+			return step{}, customerror.Wrap(
+				errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardInvalidOption),
+				fmt.Errorf("option %q", id),
+			)
+		}
+
+		opts = append(opts, raw)
+	}
+
+	aswr, err := answer.NewRanking(qst, optionIDs, opts)
+	if err != nil {
+		return step{}, err
+	}
+
+	return step{
+		qst:  qst,
+		aswr: aswr,
+		route: route{
+			nextQuestionID: qst.Meta.NextQuestionID,
+			state:          qst.Meta.State,
+		},
+		transition: event.Transition{
+			Type:       event.TransitionForwarded,
+			QuestionID: qst.GetID(),
+			OptionIDs:  optionIDs,
+		},
+	}, nil
+}
+
+// stepAttachment answers the attachment question `qst` with the metadata of
+// the stored file `m`.
+func stepAttachment(qst question.Question, m blob.Metadata) (step, error) {
+	aswr, err := answer.NewAttachment(qst, m)
+	if err != nil {
+		return step{}, err
+	}
+
+	return step{
+		qst:  qst,
+		aswr: aswr,
+		route: route{
+			nextQuestionID: qst.Meta.NextQuestionID,
+			state:          qst.Meta.State,
+		},
+		transition: event.Transition{
+			Type:       event.TransitionForwarded,
+			QuestionID: qst.GetID(),
+			Attachment: &m,
+		},
+	}, nil
+}
+
+// stepInput answers the question `qst` with the respondent-provided `value`,
+// according to the type of the question.
+func stepInput(qst question.Question, value string) (step, error) {
+	var (
+		aswr answer.Answer
+		err  error
+	)
+
+	switch qst.Type {
+	case types.Text:
+		aswr, err = answer.NewText(qst, value)
+		value = aswr.Text
+	case types.Decimal, types.Integer:
+		var n float64
+
+		n, err = strconv.ParseFloat(value, 64)
+		if err != nil {
+			err = customerror.Wrap(
+				errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerNumberInvalid),
+				err,
+			)
+
+			break
+		}
+
+		aswr, err = answer.NewNumber(qst, n)
+	case types.Date, types.DateRange, types.DateTime:
+		d := question.Date{}
+
+		if qst.Meta.Date != nil {
+			d = *qst.Meta.Date
+		}
+
+		var dates []time.Time
+
+		dates, err = d.Parse(qst.Type, value)
+		if err != nil {
+			break
+		}
+
+		aswr, err = answer.NewDates(qst, dates)
+	default:
+		err = customerror.Wrap(
+			errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardInvalidInput),
+			fmt.Errorf("question %s is of the %s type", qst.GetID(), qst.Type),
+		)
+	}
+
+	if err != nil {
+		return step{}, err
+	}
+
+	return step{
+		qst:  qst,
+		aswr: aswr,
+		route: route{
+			nextQuestionID: qst.Meta.NextQuestionID,
+			state:          qst.Meta.State,
+		},
+		transition: event.Transition{
+			Type:       event.TransitionForwarded,
+			QuestionID: qst.GetID(),
+			Value:      value,
+		},
+	}, nil
+}
+
+// stepSkipped skips the question `qst`. Required questions can't be skipped.
+func stepSkipped(qst question.Question) (step, error) {
+	if qst.Meta.Required {
+		return step{}, errorcatalog.Catalog.MustGet(errorcatalog.ErrSkipRequired)
+	}
+
+	aswr, err := answer.NewSkipped(qst)
+	if err != nil {
+		return step{}, err
+	}
+
+	return step{
+		qst:  qst,
+		aswr: aswr,
+		route: route{
+			nextQuestionID: qst.Meta.NextQuestionID,
+			state:          qst.Meta.State,
+		},
+		transition: event.Transition{
+			Type:       event.TransitionSkipped,
+			QuestionID: qst.GetID(),
+		},
+	}, nil
+}
@@ -4,6 +4,7 @@ go 1.20
 
 require (
 	github.com/google/uuid v1.3.0
+	github.com/santhosh-tekuri/jsonschema v1.2.4
 	github.com/stretchr/testify v1.8.2
 	github.com/thalesfsp/configurer v1.1.31
 	github.com/thalesfsp/customerror v1.1.6
@@ -13,6 +14,8 @@ require (
 	github.com/thalesfsp/sypl v1.9.14
 	github.com/thalesfsp/validation v0.0.2
 	go.elastic.co/apm v1.15.0
+	go.mongodb.org/mongo-driver v1.11.7
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -39,9 +42,7 @@ require (
 	github.com/pkg/errors v0.9.1 // indirect
 	github.com/pmezard/go-difflib v1.0.1-0.20181226105442-5d4384ee4fb2 // indirect
 	github.com/prometheus/procfs v0.10.0 // indirect
-	github.com/santhosh-tekuri/jsonschema v1.2.4 // indirect
 	go.elastic.co/fastjson v1.1.0 // indirect
-	go.mongodb.org/mongo-driver v1.11.7 // indirect
 	golang.org/x/crypto v0.9.0 // indirect
 	golang.org/x/lint v0.0.0-20210508222113-6edffad5e616 // indirect
 	golang.org/x/mod v0.10.0 // indirect
@@ -51,6 +52,5 @@ require (
 	golang.org/x/text v0.9.0 // indirect
 	golang.org/x/tools v0.9.1 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 	howett.net/plist v1.0.0 // indirect
 )
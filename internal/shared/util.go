@@ -13,6 +13,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/thalesfsp/customerror"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 // N asd
@@ -87,6 +88,29 @@ func Marshal(v any) ([]byte, error) {
 	return data, nil
 }
 
+// UnmarshalBSON with custom error.
+func UnmarshalBSON(data []byte, v any) error {
+	if err := bson.Unmarshal(data, v); err != nil {
+		return customerror.NewFailedToError("to unmarshal BSON",
+			customerror.WithError(err),
+		)
+	}
+
+	return nil
+}
+
+// MarshalBSON with custom error.
+func MarshalBSON(v any) ([]byte, error) {
+	data, err := bson.Marshal(v)
+	if err != nil {
+		return nil, customerror.NewFailedToError("to marshal BSON",
+			customerror.WithError(err),
+		)
+	}
+
+	return data, nil
+}
+
 // Decode process stream `r` into `v` and returns an error if any.
 func Decode(r io.Reader, v any) error {
 	if err := json.NewDecoder(r).Decode(v); err != nil {
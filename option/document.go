@@ -0,0 +1,122 @@
+package option
+
+import (
+	"encoding/json"
+
+	"github.com/thalesfsp/questionnaire/common"
+	"github.com/thalesfsp/questionnaire/internal/shared"
+	"github.com/thalesfsp/questionnaire/rule"
+	"github.com/thalesfsp/status"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// document is how an Option is persisted. Unlike the in-memory representation,
+// it exposes the next question ID, so branching survives round trips.
+type document[T shared.N] struct {
+	common.Common `json:",inline" bson:",inline"`
+
+	// Correct flags the option as a correct answer.
+	Correct bool `json:"correct,omitempty" bson:"correct,omitempty"`
+
+	// Feedback is shown after the option is chosen.
+	Feedback string `json:"feedback,omitempty" bson:"feedback,omitempty"`
+
+	// Label is the label of the option.
+	Label string `json:"label" bson:"label"`
+
+	// NextQuestionID is the next question ID.
+	NextQuestionID string `json:"nextQuestionID" bson:"nextQuestionID"`
+
+	// QuestionID is the ID of the question.
+	QuestionID string `json:"questionID" bson:"questionID"`
+
+	// Rules are the branching rules of the option.
+	Rules []rule.Rule `json:"rules,omitempty" bson:"rules,omitempty"`
+
+	// State sets the State of the option.
+	State status.Status `json:"state" bson:"state"`
+
+	// Value is the value of the option.
+	Value T `json:"value" bson:"value"`
+
+	// Weight is the weight of the option.
+	Weight int `json:"weight" bson:"weight"`
+}
+
+//////
+// Helpers.
+//////
+
+// toDocument converts the option to its persisted representation.
+func (o Option[T]) toDocument() document[T] {
+	return document[T]{
+		Common:         o.Common,
+		Correct:        o.Correct,
+		Feedback:       o.Feedback,
+		Label:          o.Label,
+		NextQuestionID: o.nextQuestionID,
+		QuestionID:     o.QuestionID,
+		Rules:          o.Rules,
+		State:          o.State,
+		Value:          o.Value,
+		Weight:         o.Weight,
+	}
+}
+
+// fromDocument sets the option from its persisted representation.
+func (o *Option[T]) fromDocument(d document[T]) {
+	o.Common = d.Common
+	o.Correct = d.Correct
+	o.Feedback = d.Feedback
+	o.Label = d.Label
+	o.QuestionID = d.QuestionID
+	o.Rules = d.Rules
+	o.State = d.State
+	o.Value = d.Value
+	o.Weight = d.Weight
+
+	o.nextQuestionID = d.NextQuestionID
+}
+
+//////
+// Serialization.
+//////
+
+// MarshalJSON implements the json.Marshaler interface.
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.toDocument())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	var d document[T]
+
+	if err := json.Unmarshal(data, &d); err != nil {
+		return err
+	}
+
+	o.fromDocument(d)
+
+	return nil
+}
+
+// MarshalBSON implements the bson.Marshaler interface.
+func (o Option[T]) MarshalBSON() ([]byte, error) {
+	return shared.MarshalBSON(o.toDocument())
+}
+
+// UnmarshalBSON implements the bson.Unmarshaler interface.
+func (o *Option[T]) UnmarshalBSON(data []byte) error {
+	var d document[T]
+
+	if err := shared.UnmarshalBSON(data, &d); err != nil {
+		return err
+	}
+
+	o.fromDocument(d)
+
+	return nil
+}
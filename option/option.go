@@ -4,6 +4,7 @@ import (
 	"github.com/thalesfsp/configurer/util"
 	"github.com/thalesfsp/questionnaire/common"
 	"github.com/thalesfsp/questionnaire/internal/shared"
+	"github.com/thalesfsp/questionnaire/rule"
 	"github.com/thalesfsp/status"
 )
 
@@ -15,12 +16,24 @@ import (
 type Option[T shared.N] struct {
 	common.Common `json:",inline" bson:",inline"`
 
+	// Correct flags the option as a correct answer, e.g.: quizzes.
+	Correct bool `json:"correct,omitempty" bson:"correct,omitempty"`
+
+	// Feedback is shown after the option is chosen, e.g.: explaining why the
+	// answer is - or isn't, correct.
+	Feedback string `json:"feedback,omitempty" bson:"feedback,omitempty"`
+
 	// Label is the label of the option.
 	Label string `json:"label" bson:"label"`
 
 	// QuestionID is the ID of the question.
 	QuestionID string `json:"questionID" bson:"questionID"`
 
+	// Rules are evaluated - in order - against previous answers when the
+	// option is chosen. The first matching rule determines the next question
+	// and/or state, taking precedence over the static ones.
+	Rules []rule.Rule `json:"rules,omitempty" bson:"rules,omitempty"`
+
 	// State sets the State of the option.
 	State status.Status `json:"state" bson:"state"`
 
@@ -31,6 +44,8 @@ type Option[T shared.N] struct {
 	Weight int `json:"weight" bson:"weight"`
 
 	// NextQuestion is the next question ID.
+	//
+	// NOTE: Persisted as `nextQuestionID` (see document.go).
 	nextQuestionID string `json:"-" bson:"-"`
 }
 
@@ -43,6 +58,11 @@ func (o Option[T]) GetID() string {
 	return o.Common.ID
 }
 
+// GetFeedback returns the feedback of the option.
+func (o Option[T]) GetFeedback() string {
+	return o.Feedback
+}
+
 // GetLabel returns the label of the option.
 func (o Option[T]) GetLabel() string {
 	return o.Label
@@ -65,6 +85,17 @@ func (o Option[T]) GetValue() T {
 	return o.Value
 }
 
+// GetAnyValue returns the value of the option as `any`. Useful when the type of
+// the option isn't known, e.g.: rules evaluation.
+func (o Option[T]) GetAnyValue() any {
+	return o.Value
+}
+
+// GetRules returns the rules of the option.
+func (o Option[T]) GetRules() []rule.Rule {
+	return o.Rules
+}
+
 // GetState returns the state determiner function.
 func (o Option[T]) GetState() status.Status {
 	return o.State
@@ -75,6 +106,11 @@ func (o Option[T]) GetWeight() int {
 	return o.Weight
 }
 
+// IsCorrect returns true if the option is a correct answer.
+func (o Option[T]) IsCorrect() bool {
+	return o.Correct
+}
+
 // NextQuestionID returns next question index.
 func (o Option[T]) NextQuestionID() string {
 	return o.nextQuestionID
@@ -107,8 +143,11 @@ func New[T shared.N](value T, params ...Func) (Option[T], error) {
 	}
 
 	o := Option[T]{
+		Correct:    p.Correct,
+		Feedback:   p.Feedback,
 		Label:      p.Label,
 		QuestionID: p.QuestionID,
+		Rules:      p.Rules,
 		Value:      value,
 		Weight:     p.Weight,
 
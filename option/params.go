@@ -3,6 +3,7 @@
 package option
 
 import (
+	"github.com/thalesfsp/questionnaire/rule"
 	"github.com/thalesfsp/status"
 )
 
@@ -49,6 +50,12 @@ type Options struct {
 	// ID of the option.
 	ID string `json:"id"`
 
+	// Correct flags the option as a correct answer.
+	Correct bool `json:"correct"`
+
+	// Feedback is shown after the option is chosen.
+	Feedback string `json:"feedback"`
+
 	// Group of the option.
 	Group string `json:"group"`
 
@@ -64,6 +71,9 @@ type Options struct {
 	// QuestionID is the ID of the question.
 	QuestionID string `json:"questionID" bson:"questionID"`
 
+	// Rules are the branching rules of the option.
+	Rules []rule.Rule `json:"rules"`
+
 	// state sets the state of the option.
 	State status.Status `json:"state"`
 
@@ -71,6 +81,25 @@ type Options struct {
 	Weight int `json:"weight"`
 }
 
+// WithCorrect flags the option as a correct answer, e.g.: quizzes. See
+// `scoring.Quiz`.
+func WithCorrect(correct bool) Func {
+	return func(o *Options) error {
+		o.Correct = correct
+
+		return nil
+	}
+}
+
+// WithFeedback sets the feedback shown after the option is chosen.
+func WithFeedback(feedback string) Func {
+	return func(o *Options) error {
+		o.Feedback = feedback
+
+		return nil
+	}
+}
+
 // WithGroup sets the group of an option.
 func WithGroup(group string) Func {
 	return func(o *Options) error {
@@ -99,6 +128,9 @@ func WithNextQuestionID(id string) Func {
 }
 
 // WithNextQuestionFunc sets the next question function.
+//
+// NOTE: `f` is called once, when the option is created. For branching based on
+// previous answers, use `WithRule`.
 func WithNextQuestionFunc(f NextQuestionFunc) Func {
 	return func(o *Options) error {
 		o.NextQuestionID = f()
@@ -107,6 +139,22 @@ func WithNextQuestionFunc(f NextQuestionFunc) Func {
 	}
 }
 
+// WithRule adds branching rules to the option. They're evaluated, in order,
+// against previous answers when the option is chosen.
+func WithRule(rules ...rule.Rule) Func {
+	return func(o *Options) error {
+		for _, r := range rules {
+			if err := r.Validate(); err != nil {
+				return err
+			}
+		}
+
+		o.Rules = append(o.Rules, rules...)
+
+		return nil
+	}
+}
+
 // WithState sets the state of the option.
 func WithState(s status.Status) Func {
 	return func(o *Options) error {
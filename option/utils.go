@@ -21,6 +21,45 @@ func MapToOption[T shared.N](m map[string]any) (Option[T], error) {
 	return o, nil
 }
 
+// ToOption converts an option of any type to Option[T]. It supports options
+// loaded from storage (e.g. JSON, BSON), and errors if the value of the option
+// isn't of type `T`.
+func ToOption[T shared.N](v any) (Option[T], error) {
+	switch o := v.(type) {
+	case Option[T]:
+		return o, nil
+	case map[string]any:
+		return MapToOption[T](o)
+	}
+
+	b, err := shared.Marshal(v)
+	if err != nil {
+		return Option[T]{}, err
+	}
+
+	var o Option[T]
+	if err := shared.Unmarshal(b, &o); err != nil {
+		return Option[T]{}, err
+	}
+
+	return o, nil
+}
+
+// ValueOf returns the value of an option of any type, including options loaded
+// from storage (e.g. JSON). `ok` is false if `v` isn't an option.
+func ValueOf(v any) (any, bool) {
+	switch o := v.(type) {
+	case interface{ GetAnyValue() any }:
+		return o.GetAnyValue(), true
+	case map[string]any:
+		value, ok := o["value"]
+
+		return value, ok
+	}
+
+	return nil, false
+}
+
 // AnyToOption converts any to the proper Option[Type] then to any.
 //
 //nolint:gocritic,forcetypeassert,gosimple
@@ -0,0 +1,75 @@
+package question
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/thalesfsp/customerror"
+	"github.com/thalesfsp/questionnaire/errorcatalog"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// Attachment constraints of file answers.
+type Attachment struct {
+	// MaxSize is the maximum size, in bytes. Zero means no limit.
+	MaxSize int64 `json:"maxSize,omitempty" bson:"maxSize,omitempty"`
+
+	// MIMETypes are the allowed MIME types, e.g.: "application/pdf", or
+	// "image/*". Empty means any.
+	MIMETypes []string `json:"mimeTypes,omitempty" bson:"mimeTypes,omitempty"`
+}
+
+//////
+// Methods.
+//////
+
+// Check the size, and the MIME type of the file against the constraints.
+func (a Attachment) Check(size int64, mimeType string) error {
+	if a.MaxSize > 0 && size > a.MaxSize {
+		return customerror.Wrap(
+			errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerAttachmentSize),
+			fmt.Errorf("%d bytes", size),
+		)
+	}
+
+	if len(a.MIMETypes) == 0 {
+		return nil
+	}
+
+	for _, allowed := range a.MIMETypes {
+		if strings.HasSuffix(allowed, "/*") {
+			if strings.HasPrefix(mimeType, strings.TrimSuffix(allowed, "*")) {
+				return nil
+			}
+
+			continue
+		}
+
+		if strings.EqualFold(allowed, mimeType) {
+			return nil
+		}
+	}
+
+	return customerror.Wrap(
+		errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerAttachmentType),
+		fmt.Errorf("%s", mimeType),
+	)
+}
+
+// Validate the constraints.
+func (a Attachment) Validate() error {
+	if a.MaxSize < 0 {
+		return customerror.NewInvalidError("attachment max size")
+	}
+
+	for _, t := range a.MIMETypes {
+		if strings.Count(t, "/") != 1 || strings.HasPrefix(t, "/") || strings.HasSuffix(t, "/") {
+			return customerror.NewInvalidError("attachment MIME type " + t)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,277 @@
+package question
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thalesfsp/customerror"
+	"github.com/thalesfsp/questionnaire/errorcatalog"
+	"github.com/thalesfsp/questionnaire/types"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+const (
+	// DateLayout is the RFC 3339 full-date layout.
+	DateLayout = "2006-01-02"
+
+	// DateRangeSeparator separates the start, and the end of a date range,
+	// e.g.: "2024-01-01/2024-01-31".
+	DateRangeSeparator = "/"
+
+	// dateTimeLocalLayout is the RFC 3339 date-time layout without offset.
+	dateTimeLocalLayout = "2006-01-02T15:04:05.999999999"
+)
+
+// relativeRegex matches calendar offsets of relative bounds, e.g.: "-18y".
+var relativeRegex = regexp.MustCompile(`^([+-]\d+)(y|mo|w|d)$`)
+
+// now returns the current time. It allows to test relative bounds.
+var now = time.Now
+
+// Date constraints of date, date-time, and date range answers.
+type Date struct {
+	// Location is the IANA time zone, e.g.: "America/Sao_Paulo". Dates, and
+	// date-times without offset are interpreted in it, and so are the days of
+	// relative bounds. Defaults to UTC.
+	Location string `json:"location,omitempty" bson:"location,omitempty"`
+
+	// Max is the maximum date, inclusive. It's absolute (RFC 3339), or
+	// relative to now, e.g.: "now" (not in the future), "now+30d", "now-2h".
+	Max string `json:"max,omitempty" bson:"max,omitempty"`
+
+	// Min is the minimum date, inclusive. It's absolute (RFC 3339), or
+	// relative to now, e.g.: "now-18y", "now-1mo".
+	Min string `json:"min,omitempty" bson:"min,omitempty"`
+}
+
+//////
+// Methods.
+//////
+
+// LoadLocation returns the time zone of the constraints.
+func (d Date) LoadLocation() (*time.Location, error) {
+	loc, err := time.LoadLocation(d.Location)
+	if err != nil {
+		return nil, customerror.NewInvalidError("date location", customerror.WithError(err))
+	}
+
+	return loc, nil
+}
+
+// Parse the respondent-provided `value` according to the question type `t`:
+// a full-date (date), an RFC 3339 date-time - the offset is optional (date
+// time), or two full-dates separated by `DateRangeSeparator` (date range).
+func (d Date) Parse(t types.Type, value string) ([]time.Time, error) {
+	loc, err := d.LoadLocation()
+	if err != nil {
+		return nil, err
+	}
+
+	var values []string
+
+	switch t {
+	case types.Date, types.DateTime:
+		values = []string{strings.TrimSpace(value)}
+	case types.DateRange:
+		values = strings.Split(value, DateRangeSeparator)
+
+		if len(values) != 2 {
+			return nil, customerror.Wrap(
+				errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerDateInvalid),
+				fmt.Errorf("%q isn't a range", value),
+			)
+		}
+	default:
+		return nil, customerror.NewInvalidError("date type " + t.String())
+	}
+
+	dates := make([]time.Time, 0, len(values))
+
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+
+		var parsed time.Time
+
+		if t == types.DateTime {
+			parsed, err = time.Parse(time.RFC3339Nano, v)
+			if err != nil {
+				parsed, err = time.ParseInLocation(dateTimeLocalLayout, v, loc)
+			}
+		} else {
+			parsed, err = time.ParseInLocation(DateLayout, v, loc)
+		}
+
+		if err != nil {
+			return nil, customerror.Wrap(
+				errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerDateInvalid),
+				err,
+			)
+		}
+
+		dates = append(dates, parsed)
+	}
+
+	return dates, nil
+}
+
+// Check the dates against the constraints, according to the question type
+// `t`. Date ranges must start before, or when they end.
+func (d Date) Check(t types.Type, dates ...time.Time) error {
+	want := 1
+
+	if t == types.DateRange {
+		want = 2
+	}
+
+	if len(dates) != want || (want == 2 && dates[0].After(dates[1])) {
+		return customerror.Wrap(
+			errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerDateInvalid),
+			fmt.Errorf("%v", dates),
+		)
+	}
+
+	loc, err := d.LoadLocation()
+	if err != nil {
+		return err
+	}
+
+	min, max, err := d.bounds(t, loc)
+	if err != nil {
+		return err
+	}
+
+	for _, date := range dates {
+		if (!min.IsZero() && date.Before(min)) || (!max.IsZero() && date.After(max)) {
+			return customerror.Wrap(
+				errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerDateRange),
+				fmt.Errorf("%s", date.Format(time.RFC3339)),
+			)
+		}
+	}
+
+	return nil
+}
+
+// Validate the constraints.
+func (d Date) Validate() error {
+	loc, err := d.LoadLocation()
+	if err != nil {
+		return err
+	}
+
+	min, max, err := d.bounds(types.DateTime, loc)
+	if err != nil {
+		return err
+	}
+
+	if !min.IsZero() && !max.IsZero() && min.After(max) {
+		return customerror.NewInvalidError("date bounds")
+	}
+
+	return nil
+}
+
+// bounds resolves the minimum, and maximum dates. Unset bounds are zero. For
+// dates, and date ranges bounds are truncated to the day.
+func (d Date) bounds(t types.Type, loc *time.Location) (time.Time, time.Time, error) {
+	var resolved [2]time.Time
+
+	for i, s := range []string{d.Min, d.Max} {
+		if s == "" {
+			continue
+		}
+
+		b, err := resolve(s, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+
+		if t != types.DateTime {
+			y, m, day := b.In(loc).Date()
+
+			b = time.Date(y, m, day, 0, 0, 0, 0, loc)
+		}
+
+		resolved[i] = b
+	}
+
+	return resolved[0], resolved[1], nil
+}
+
+//////
+// Exported functionalities.
+//////
+
+// FormatDate formats the dates according to the question type `t`. It's the
+// inverse of `Date.Parse`.
+func FormatDate(t types.Type, dates ...time.Time) string {
+	values := make([]string, 0, len(dates))
+
+	for _, d := range dates {
+		if t == types.DateTime {
+			values = append(values, d.Format(time.RFC3339Nano))
+		} else {
+			values = append(values, d.Format(DateLayout))
+		}
+	}
+
+	return strings.Join(values, DateRangeSeparator)
+}
+
+//////
+// Helpers.
+//////
+
+// resolve a bound, absolute, or relative to now.
+func resolve(s string, loc *time.Location) (time.Time, error) {
+	if strings.HasPrefix(s, "now") {
+		offset := strings.TrimPrefix(s, "now")
+		n := now().In(loc)
+
+		if offset == "" {
+			return n, nil
+		}
+
+		if m := relativeRegex.FindStringSubmatch(offset); m != nil {
+			v, err := strconv.Atoi(m[1])
+			if err != nil {
+				return time.Time{}, customerror.NewInvalidError("date bound "+s, customerror.WithError(err))
+			}
+
+			switch m[2] {
+			case "y":
+				return n.AddDate(v, 0, 0), nil
+			case "mo":
+				return n.AddDate(0, v, 0), nil
+			case "w":
+				return n.AddDate(0, 0, 7*v), nil
+			default:
+				return n.AddDate(0, 0, v), nil
+			}
+		}
+
+		duration, err := time.ParseDuration(offset)
+		if err != nil {
+			return time.Time{}, customerror.NewInvalidError("date bound "+s, customerror.WithError(err))
+		}
+
+		return n.Add(duration), nil
+	}
+
+	if b, err := time.ParseInLocation(DateLayout, s, loc); err == nil {
+		return b, nil
+	}
+
+	b, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, customerror.NewInvalidError("date bound "+s, customerror.WithError(err))
+	}
+
+	return b, nil
+}
@@ -0,0 +1,210 @@
+package question
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+
+	"github.com/thalesfsp/go-common-types/safeorderedmap"
+	"github.com/thalesfsp/questionnaire/common"
+	"github.com/thalesfsp/questionnaire/internal/shared"
+	"github.com/thalesfsp/questionnaire/option"
+	"github.com/thalesfsp/questionnaire/types"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// questionAlias is Question without its methods, avoiding infinite recursion
+// while unmarshalling.
+type questionAlias Question
+
+// jsonDocument is how a Question is persisted in JSON. Options are stored as
+// an ordered list - JSON objects are unordered.
+type jsonDocument struct {
+	*questionAlias
+
+	// Options is the ordered list of options.
+	Options json.RawMessage `json:"options"`
+}
+
+// document is how a Question is persisted in BSON. Options are stored as an
+// ordered list.
+//
+// NOTE: `O` is `any` when marshalling, and `option.Option[any]` when
+// unmarshalling - the actual type of the options isn't known at that point.
+type document[O any] struct {
+	common.Common `bson:",inline"`
+
+	// Meta is the metadata of the question.
+	Meta Meta `bson:"meta"`
+
+	// Label is the question.
+	Label string `bson:"label"`
+
+	// Options is the ordered list of options.
+	Options []O `bson:"options"`
+
+	// PreviousQuestionID is the ID of the previous question.
+	PreviousQuestionID string `bson:"previousQuestionID,omitempty"`
+
+	// Type of the question.
+	Type types.Type `bson:"type"`
+}
+
+//////
+// Helpers.
+//////
+
+// sortScale restores the order of the options of a scale question based on
+// their points.
+func sortScale(options *safeorderedmap.SafeOrderedMap[any]) *safeorderedmap.SafeOrderedMap[any] {
+	keys := options.Keys()
+	points := make(map[string]float64, len(keys))
+
+	for _, k := range keys {
+		v, _ := options.Get(k)
+
+		value, _ := option.ValueOf(v)
+
+		points[k], _ = value.(float64)
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool {
+		return points[keys[i]] < points[keys[j]]
+	})
+
+	sorted := safeorderedmap.New[any]()
+
+	for _, k := range keys {
+		v, _ := options.Get(k)
+
+		sorted.Add(k, v)
+	}
+
+	return sorted
+}
+
+//////
+// Serialization.
+//////
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q Question) MarshalJSON() ([]byte, error) {
+	var options []any
+
+	if q.Options != nil {
+		options = append([]any{}, q.Options.Values()...)
+	}
+
+	a := questionAlias(q)
+
+	return json.Marshal(struct {
+		*questionAlias
+
+		Options []any `json:"options"`
+	}{&a, options})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Options are stored
+// as an ordered list. Documents storing them as an object - unordered, are
+// supported, restoring the order of the options of scale questions.
+func (q *Question) UnmarshalJSON(data []byte) error {
+	var a questionAlias
+
+	d := jsonDocument{questionAlias: &a}
+
+	if err := json.Unmarshal(data, &d); err != nil {
+		return err
+	}
+
+	options := bytes.TrimSpace(d.Options)
+
+	switch {
+	case len(options) == 0 || bytes.Equal(options, []byte("null")):
+		a.Options = nil
+	case options[0] == '[':
+		var list []map[string]any
+
+		if err := json.Unmarshal(options, &list); err != nil {
+			return err
+		}
+
+		a.Options = safeorderedmap.New[any]()
+
+		for _, o := range list {
+			id, _ := o["id"].(string)
+
+			a.Options.Add(id, o)
+		}
+	default:
+		a.Options = safeorderedmap.New[any]()
+
+		if err := json.Unmarshal(options, a.Options); err != nil {
+			return err
+		}
+
+		if a.Meta.Scale != nil {
+			a.Options = sortScale(a.Options)
+		}
+	}
+
+	*q = Question(a)
+
+	return nil
+}
+
+// MarshalBSON implements the bson.Marshaler interface.
+func (q Question) MarshalBSON() ([]byte, error) {
+	d := document[any]{
+		Common:             q.Common,
+		Meta:               q.Meta,
+		Label:              q.Label,
+		Options:            []any{},
+		PreviousQuestionID: q.PreviousQuestionID,
+		Type:               q.Type,
+	}
+
+	// Options loaded from storage (e.g. JSON) are maps, so they're converted
+	// back to options, persisting them consistently.
+	if q.Options != nil {
+		for _, v := range q.Options.Values() {
+			if m, ok := v.(map[string]any); ok {
+				o, err := option.MapToOption[any](m)
+				if err != nil {
+					return nil, err
+				}
+
+				v = o
+			}
+
+			d.Options = append(d.Options, v)
+		}
+	}
+
+	return shared.MarshalBSON(d)
+}
+
+// UnmarshalBSON implements the bson.Unmarshaler interface.
+func (q *Question) UnmarshalBSON(data []byte) error {
+	var d document[option.Option[any]]
+
+	if err := shared.UnmarshalBSON(data, &d); err != nil {
+		return err
+	}
+
+	q.Common = d.Common
+	q.Meta = d.Meta
+	q.Label = d.Label
+	q.PreviousQuestionID = d.PreviousQuestionID
+	q.Type = d.Type
+
+	q.Options = safeorderedmap.New[any]()
+
+	for _, o := range d.Options {
+		q.Options.Add(o.GetID(), o)
+	}
+
+	return nil
+}
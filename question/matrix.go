@@ -0,0 +1,113 @@
+package question
+
+import (
+	"fmt"
+
+	"github.com/thalesfsp/customerror"
+	"github.com/thalesfsp/questionnaire/errorcatalog"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// RowSeparator separates the question ID, and the row ID referencing a row of
+// a matrix question, e.g.: in rules' conditions - "satisfaction.price".
+const RowSeparator = "."
+
+// Row of a matrix question.
+type Row struct {
+	// ID of the row.
+	ID string `json:"id" bson:"id"`
+
+	// Label of the row, e.g.: "Price".
+	Label string `json:"label" bson:"label"`
+
+	// Required is a flag to indicate if the row must be answered.
+	Required bool `json:"required,omitempty" bson:"required,omitempty"`
+}
+
+// Matrix of rows sharing one set of columns - the question's options, e.g.:
+// "rate each of these aspects on 1-5". The whole grid is answered at once, and
+// routed by the question's default next question, or state - and its rules.
+type Matrix struct {
+	// Multiple allows several columns per row, e.g.: checkbox grids. Its
+	// number is constrained by `Meta.Selection` - per row.
+	Multiple bool `json:"multiple,omitempty" bson:"multiple,omitempty"`
+
+	// Rows of the matrix, in order.
+	Rows []Row `json:"rows" bson:"rows"`
+}
+
+//////
+// Methods.
+//////
+
+// Row returns the row `id`.
+func (m Matrix) Row(id string) (Row, bool) {
+	for _, r := range m.Rows {
+		if r.ID == id {
+			return r, true
+		}
+	}
+
+	return Row{}, false
+}
+
+// Check the number of selected columns per row (`counts`) against the
+// constraints. `s` - if any, constrains multiple columns per row.
+func (m Matrix) Check(counts map[string]int, s *Selection) error {
+	for id := range counts {
+		if _, ok := m.Row(id); !ok {
+			return customerror.Wrap(
+				errorcatalog.Catalog.MustGet(errorcatalog.ErrForwardInvalidRow),
+				fmt.Errorf("row %q", id),
+			)
+		}
+	}
+
+	for _, r := range m.Rows {
+		n := counts[r.ID]
+
+		if n == 0 && r.Required {
+			return customerror.Wrap(
+				errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerRowRequired),
+				fmt.Errorf("row %q", r.ID),
+			)
+		}
+
+		if !m.Multiple && n > 1 {
+			return customerror.Wrap(
+				errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerSelectionCount),
+				fmt.Errorf("row %q, %d selected", r.ID, n),
+			)
+		}
+
+		if m.Multiple && s != nil {
+			if err := s.Check(n); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Validate the matrix.
+func (m Matrix) Validate() error {
+	if len(m.Rows) == 0 {
+		return customerror.NewRequiredError("matrix rows")
+	}
+
+	seen := make(map[string]bool, len(m.Rows))
+
+	for _, r := range m.Rows {
+		if r.ID == "" || seen[r.ID] {
+			return customerror.NewInvalidError("matrix row ID " + r.ID)
+		}
+
+		seen[r.ID] = true
+	}
+
+	return nil
+}
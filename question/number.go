@@ -0,0 +1,105 @@
+package question
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/thalesfsp/customerror"
+	"github.com/thalesfsp/questionnaire/errorcatalog"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// stepTolerance is the tolerance checking steps of decimals, e.g.: 0.1 + 0.2.
+const stepTolerance = 1e-9
+
+// Number constraints of numeric answers.
+//
+// NOTE: Bounds are flagged (`HasMax`, `HasMin`) instead of pointers, because
+// zero is a valid bound, and flags keep bounds plain values: literals, e.g.:
+// `Number{HasMin: true, Min: 18}`, don't need a variable to point to.
+type Number struct {
+	// HasMax is a flag to indicate if `Max` is set.
+	HasMax bool `json:"hasMax,omitempty" bson:"hasMax,omitempty"`
+
+	// HasMin is a flag to indicate if `Min` is set.
+	HasMin bool `json:"hasMin,omitempty" bson:"hasMin,omitempty"`
+
+	// Max is the maximum value, inclusive.
+	Max float64 `json:"max,omitempty" bson:"max,omitempty"`
+
+	// Min is the minimum value, inclusive.
+	Min float64 `json:"min,omitempty" bson:"min,omitempty"`
+
+	// Step between valid values, starting from `Min` - if set, or zero. Zero
+	// means any value.
+	Step float64 `json:"step,omitempty" bson:"step,omitempty"`
+
+	// Unit label, e.g.: "kg", "mg/dL".
+	Unit string `json:"unit,omitempty" bson:"unit,omitempty"`
+}
+
+//////
+// Methods.
+//////
+
+// Check the number against the constraints. `integer` requires a whole
+// number.
+func (n Number) Check(v float64, integer bool) error {
+	if math.IsNaN(v) || math.IsInf(v, 0) || (integer && v != math.Trunc(v)) {
+		return customerror.Wrap(
+			errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerNumberInvalid),
+			fmt.Errorf("%v", v),
+		)
+	}
+
+	if (n.HasMin && v < n.Min) || (n.HasMax && v > n.Max) {
+		return customerror.Wrap(
+			errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerNumberRange),
+			fmt.Errorf("%v", v),
+		)
+	}
+
+	if n.Step > 0 {
+		base := 0.0
+
+		if n.HasMin {
+			base = n.Min
+		}
+
+		steps := (v - base) / n.Step
+
+		if math.Abs(steps-math.Round(steps)) > stepTolerance {
+			return customerror.Wrap(
+				errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerNumberStep),
+				fmt.Errorf("%v, step %v", v, n.Step),
+			)
+		}
+	}
+
+	return nil
+}
+
+// Validate the constraints.
+func (n Number) Validate() error {
+	if n.HasMin && n.HasMax && n.Min > n.Max {
+		return customerror.NewInvalidError("number bounds")
+	}
+
+	if n.Step < 0 {
+		return customerror.NewInvalidError("number step")
+	}
+
+	return nil
+}
+
+//////
+// Factory.
+//////
+
+// Range creates number constraints with both bounds, `low`, and `high`.
+func Range(low, high float64) Number {
+	return Number{HasMax: true, HasMin: true, Max: high, Min: low}
+}
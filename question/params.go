@@ -5,6 +5,9 @@ package question
 import (
 	"github.com/thalesfsp/questionnaire/internal/shared"
 	"github.com/thalesfsp/questionnaire/option"
+	"github.com/thalesfsp/questionnaire/rule"
+	"github.com/thalesfsp/questionnaire/validator"
+	"github.com/thalesfsp/status"
 )
 
 //////
@@ -26,6 +29,32 @@ func WithMeta(meta *Meta) Func {
 	}
 }
 
+// WithAttachment sets the constraints of file answers.
+func WithAttachment(a Attachment) Func {
+	return func(m *Meta) error {
+		if err := a.Validate(); err != nil {
+			return err
+		}
+
+		m.Attachment = &a
+
+		return nil
+	}
+}
+
+// WithDate sets the constraints of date, date-time, and date range answers.
+func WithDate(d Date) Func {
+	return func(m *Meta) error {
+		if err := d.Validate(); err != nil {
+			return err
+		}
+
+		m.Date = &d
+
+		return nil
+	}
+}
+
 // WithImageURL sets the question image URL.
 func WithImageURL(imageURL string) Func {
 	return func(m *Meta) error {
@@ -35,6 +64,19 @@ func WithImageURL(imageURL string) Func {
 	}
 }
 
+// WithRanking sets the constraints of ranking answers.
+func WithRanking(r Ranking) Func {
+	return func(m *Meta) error {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+
+		m.Ranking = &r
+
+		return nil
+	}
+}
+
 // WithRequired sets the question as required.
 func WithRequired(required bool) Func {
 	return func(m *Meta) error {
@@ -44,6 +86,108 @@ func WithRequired(required bool) Func {
 	}
 }
 
+// WithMatrix sets the rows of a matrix question. Its options are the columns.
+func WithMatrix(mx Matrix) Func {
+	return func(m *Meta) error {
+		if err := mx.Validate(); err != nil {
+			return err
+		}
+
+		m.Matrix = &mx
+
+		return nil
+	}
+}
+
+// WithNextQuestionID sets the default next question ID.
+func WithNextQuestionID(id string) Func {
+	return func(m *Meta) error {
+		m.NextQuestionID = id
+
+		return nil
+	}
+}
+
+// WithNumber sets the constraints of numeric answers.
+func WithNumber(n Number) Func {
+	return func(m *Meta) error {
+		if err := n.Validate(); err != nil {
+			return err
+		}
+
+		m.Number = &n
+
+		return nil
+	}
+}
+
+// WithRule adds branching rules to the question. They're evaluated, in order,
+// against previous answers, after the chosen option's ones.
+func WithRule(rules ...rule.Rule) Func {
+	return func(m *Meta) error {
+		for _, r := range rules {
+			if err := r.Validate(); err != nil {
+				return err
+			}
+		}
+
+		m.Rules = append(m.Rules, rules...)
+
+		return nil
+	}
+}
+
+// WithSelection sets the constraints of multiple-select answers.
+func WithSelection(s Selection) Func {
+	return func(m *Meta) error {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+
+		m.Selection = &s
+
+		return nil
+	}
+}
+
+// WithState sets the default state of the question.
+func WithState(s status.Status) Func {
+	return func(m *Meta) error {
+		if s != status.None {
+			m.State = s
+		}
+
+		return nil
+	}
+}
+
+// WithText sets the constraints of free-text answers.
+func WithText(t Text) Func {
+	return func(m *Meta) error {
+		if err := t.Validate(); err != nil {
+			return err
+		}
+
+		m.Text = &t
+
+		return nil
+	}
+}
+
+// WithValidator adds named validators, run against the answer's value. They
+// must be registered - see `validator.Register`.
+func WithValidator(refs ...validator.Ref) Func {
+	return func(m *Meta) error {
+		if err := validator.Default.Validate(refs...); err != nil {
+			return err
+		}
+
+		m.Validators = append(m.Validators, refs...)
+
+		return nil
+	}
+}
+
 // WithWeight sets the question weight.
 func WithWeight(weight int) Func {
 	return func(m *Meta) error {
@@ -2,11 +2,16 @@ package question
 
 import (
 	"github.com/thalesfsp/configurer/util"
+	"github.com/thalesfsp/customerror"
 	"github.com/thalesfsp/go-common-types/safeorderedmap"
 	"github.com/thalesfsp/questionnaire/common"
+	"github.com/thalesfsp/questionnaire/errorcatalog"
 	"github.com/thalesfsp/questionnaire/internal/shared"
 	"github.com/thalesfsp/questionnaire/option"
+	"github.com/thalesfsp/questionnaire/rule"
 	"github.com/thalesfsp/questionnaire/types"
+	"github.com/thalesfsp/questionnaire/validator"
+	"github.com/thalesfsp/status"
 )
 
 //////
@@ -18,15 +23,55 @@ type Meta struct {
 	// ID of the question.
 	ID string `json:"id" bson:"id"`
 
+	// Attachment constraints of file answers.
+	Attachment *Attachment `json:"attachment,omitempty" bson:"attachment,omitempty"`
+
+	// Date constraints of date, date-time, and date range answers.
+	Date *Date `json:"date,omitempty" bson:"date,omitempty"`
+
 	// ImageURL is the URL of the image.
 	ImageURL string `json:"url" bson:"url"`
 
 	// Index is the index of the question.
 	Index int `json:"index" bson:"index"`
 
+	// Matrix of rows sharing the question's options as columns.
+	Matrix *Matrix `json:"matrix,omitempty" bson:"matrix,omitempty"`
+
+	// NextQuestionID is the default next question ID. Used, for example, when
+	// the question is skipped.
+	NextQuestionID string `json:"nextQuestionID" bson:"nextQuestionID"`
+
+	// Rules are evaluated - in order - against previous answers, after the
+	// option's ones. The first matching rule determines the next question
+	// and/or state.
+	Rules []rule.Rule `json:"rules,omitempty" bson:"rules,omitempty"`
+
+	// Number constraints of numeric answers.
+	Number *Number `json:"number,omitempty" bson:"number,omitempty"`
+
+	// Ranking constraints of ranking answers.
+	Ranking *Ranking `json:"ranking,omitempty" bson:"ranking,omitempty"`
+
 	// Required is a flag to indicate if the question is required.
 	Required bool `json:"required" default:"false" bson:"required"`
 
+	// Scale of ordinal questions, e.g.: Likert, rating, and NPS.
+	Scale *Scale `json:"scale,omitempty" bson:"scale,omitempty"`
+
+	// Selection constraints of multiple-select answers.
+	Selection *Selection `json:"selection,omitempty" bson:"selection,omitempty"`
+
+	// State is the default state set when there's no next question. Used, for
+	// example, when the last question is skipped.
+	State status.Status `json:"state" bson:"state"`
+
+	// Text constraints of free-text answers.
+	Text *Text `json:"text,omitempty" bson:"text,omitempty"`
+
+	// Validators are run - by name, against the answer's value, e.g.: email.
+	Validators []validator.Ref `json:"validators,omitempty" bson:"validators,omitempty"`
+
 	// Weight is the weight of the question.
 	Weight int `json:"weight" bson:"weight"`
 
@@ -48,7 +93,7 @@ type Question struct {
 	Options *safeorderedmap.SafeOrderedMap[any] `json:"options" bson:"options"`
 
 	// PreviousQuestionID is the ID of the previous question.
-	PreviousQuestionID string `json:"-" bson:"-"`
+	PreviousQuestionID string `json:"previousQuestionID,omitempty" bson:"previousQuestionID,omitempty"`
 
 	// Type of the question.
 	Type types.Type `json:"type" bson:"type"`
@@ -80,13 +125,25 @@ func AddOption[T shared.N](q *Question, o ...option.Option[T]) {
 	}
 }
 
-// GetOption returns an option from the question.
-//
-//nolint:forcetypeassert
+// GetOption returns an option from the question. It supports options added
+// programmatically, and options loaded from storage (e.g. JSON). An error is
+// returned if the option doesn't exist, or if it isn't of type `T`.
 func GetOption[T shared.N](q Question, id string) (option.Option[T], error) {
-	opt, _ := q.Options.Get(id)
+	if q.Options == nil {
+		return option.Option[T]{}, customerror.NewNotFoundError("option " + id)
+	}
+
+	opt, ok := q.Options.Get(id)
+	if !ok {
+		return option.Option[T]{}, customerror.NewNotFoundError("option " + id)
+	}
+
+	o, err := option.ToOption[T](opt)
+	if err != nil {
+		return option.Option[T]{}, errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerOptionType)
+	}
 
-	return option.MapToOption[T](opt.(map[string]any))
+	return o, nil
 }
 
 //////
@@ -109,6 +166,7 @@ func New[T shared.N](
 		ID:       id,
 		ImageURL: "",
 		Required: false,
+		State:    status.None,
 		options:  []any{},
 		Weight:   1,
 	}
@@ -2,11 +2,15 @@ package question
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/thalesfsp/questionnaire/errorcatalog"
 	"github.com/thalesfsp/questionnaire/internal/shared"
 	"github.com/thalesfsp/questionnaire/option"
+	"github.com/thalesfsp/questionnaire/rule"
 	"github.com/thalesfsp/questionnaire/types"
+	"github.com/thalesfsp/status"
 )
 
 func TestQuestion_MarshalJSON(t *testing.T) {
@@ -28,6 +32,7 @@ func TestQuestion_MarshalJSON(t *testing.T) {
 					option.WithGroup("g1"),
 					option.WithLabel("Olabel1"),
 					option.WithID(oID),
+					option.WithNextQuestionID("id2"),
 				)),
 			)
 
@@ -42,6 +47,8 @@ func TestQuestion_MarshalJSON(t *testing.T) {
 			opt, err := GetOption[int](q2, oID)
 			assert.NoError(t, err)
 			assert.EqualValues(t, 1, opt.Value)
+			assert.EqualValues(t, "id2", opt.NextQuestionID())
+			assert.EqualValues(t, "id1", opt.GetQuestionID())
 
 			assert.EqualValues(t, q.Meta.ImageURL, q2.Meta.ImageURL)
 			assert.EqualValues(t, q.Meta.Required, q2.Meta.Required)
@@ -49,6 +56,534 @@ func TestQuestion_MarshalJSON(t *testing.T) {
 			assert.EqualValues(t, q.Label, q2.Label)
 			assert.EqualValues(t, q.PreviousQuestionID, q2.PreviousQuestionID)
 			assert.EqualValues(t, q.Type, q2.Type)
+
+			// Order is preserved.
+			ids := []string{"z", "y", "x", "w", "v", "u", "t", "s"}
+			opts := make([]option.Option[string], 0, len(ids))
+
+			for _, id := range ids {
+				opts = append(opts, option.MustNew(id, option.WithID(id), option.WithNextQuestionID("id2")))
+			}
+
+			q3 := MustNew[string]("id3", "Qlabel3", types.MultipleSelect, WithOption(opts...))
+
+			got, err = shared.Marshal(&q3)
+			assert.NoError(t, err)
+
+			var q4 Question
+
+			assert.NoError(t, shared.Unmarshal(got, &q4))
+			assert.Equal(t, ids, q4.Options.Keys())
+
+			// Options stored as an object are supported.
+			var q5 Question
+
+			assert.NoError(t, shared.Unmarshal([]byte(`{"id":"id4","options":{"a":{"id":"a","value":"a"}}}`), &q5))
+			assert.Equal(t, []string{"a"}, q5.Options.Keys())
+		})
+	}
+}
+
+func TestQuestion_MarshalBSON(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "Should work",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := MustNew[int]("id1", "Qlabel1", types.SingleSelect,
+				WithOption(
+					option.MustNew(1, option.WithID("oID1"), option.WithNextQuestionID("id2")),
+					option.MustNew(2, option.WithID("oID2"), option.WithState(status.Completed)),
+					option.MustNew(3, option.WithID("oID3"), option.WithRule(
+						rule.GoTo("id3", rule.If("id0", rule.GreaterThan, 10)),
+					)),
+				),
+				WithNextQuestionID("id4"),
+				WithRule(rule.GoTo("id5", rule.If("id0", rule.In, []string{"a", "b"}))),
+			)
+
+			got, err := shared.MarshalBSON(q)
+			assert.NoError(t, err)
+
+			var q2 Question
+			assert.NoError(t, shared.UnmarshalBSON(got, &q2))
+
+			// Order is preserved.
+			assert.Equal(t, []string{"oID1", "oID2", "oID3"}, q2.Options.Keys())
+
+			opt1, err := GetOption[int](q2, "oID1")
+			assert.NoError(t, err)
+			assert.Equal(t, 1, opt1.Value)
+			assert.Equal(t, "id2", opt1.NextQuestionID())
+			assert.Equal(t, "id1", opt1.GetQuestionID())
+
+			opt2, err := GetOption[int](q2, "oID2")
+			assert.NoError(t, err)
+			assert.Equal(t, status.Completed, opt2.GetState())
+
+			opt3, err := GetOption[int](q2, "oID3")
+			assert.NoError(t, err)
+			assert.Len(t, opt3.GetRules(), 1)
+			assert.Equal(t, "id3", opt3.GetRules()[0].NextQuestionID)
+
+			assert.Equal(t, "id4", q2.Meta.NextQuestionID)
+			assert.Len(t, q2.Meta.Rules, 1)
+			assert.Equal(t, q.Label, q2.Label)
+			assert.Equal(t, q.Type, q2.Type)
+
+			// Options loaded from JSON can be persisted as BSON too.
+			j, err := shared.Marshal(&q)
+			assert.NoError(t, err)
+
+			var q3 Question
+			assert.NoError(t, shared.Unmarshal(j, &q3))
+
+			got, err = shared.MarshalBSON(q3)
+			assert.NoError(t, err)
+
+			var q4 Question
+			assert.NoError(t, shared.UnmarshalBSON(got, &q4))
+
+			opt1, err = GetOption[int](q4, "oID1")
+			assert.NoError(t, err)
+			assert.Equal(t, "oID1", opt1.GetID())
+			assert.Equal(t, "id2", opt1.NextQuestionID())
+		})
+	}
+}
+
+func TestText_Check(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    Text
+		value   string
+		wantErr string
+	}{
+		{
+			name:  "Should work",
+			text:  Text{MinLength: 2, MaxLength: 5, Pattern: `^\p{L}+$`, Trim: true},
+			value: "  maçã ",
+		},
+		{
+			name:  "Should work - empty isn't checked",
+			text:  Text{MinLength: 2},
+			value: "",
+		},
+		{
+			name:    "Should fail - too short",
+			text:    Text{MinLength: 2},
+			value:   "a",
+			wantErr: errorcatalog.ErrAnswerTextLength,
+		},
+		{
+			name:    "Should fail - too long",
+			text:    Text{MaxLength: 3},
+			value:   "abcd",
+			wantErr: errorcatalog.ErrAnswerTextLength,
+		},
+		{
+			name:    "Should fail - pattern",
+			text:    Text{Pattern: `^\d+$`},
+			value:   "12a",
+			wantErr: errorcatalog.ErrAnswerTextPattern,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := New[string]("1", "Name?", types.Text, WithText(tt.text))
+			assert.NoError(t, err)
+
+			err = q.Meta.Text.Check(q.Meta.Text.Normalize(tt.value))
+			if tt.wantErr != "" {
+				assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(tt.wantErr))
+
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+
+	_, err := New[string]("1", "Name?", types.Text, WithText(Text{Pattern: `(`}))
+	assert.Error(t, err)
+
+	_, err = New[string]("1", "Name?", types.Text, WithText(Text{MinLength: 3, MaxLength: 2}))
+	assert.Error(t, err)
+}
+
+func TestNumber_Check(t *testing.T) {
+	tests := []struct {
+		name    string
+		number  Number
+		integer bool
+		value   float64
+		wantErr string
+	}{
+		{
+			name:    "Should work",
+			number:  Range(0, 120),
+			integer: true,
+			value:   18,
+		},
+		{
+			name:   "Should work - decimal step",
+			number: Number{HasMin: true, Min: 0.5, Step: 0.1, Unit: "mg"},
+			value:  0.7,
+		},
+		{
+			name:    "Should fail - not an integer",
+			number:  Range(0, 120),
+			integer: true,
+			value:   18.5,
+			wantErr: errorcatalog.ErrAnswerNumberInvalid,
+		},
+		{
+			name:    "Should fail - below min",
+			number:  Range(0, 120),
+			value:   -1,
+			wantErr: errorcatalog.ErrAnswerNumberRange,
+		},
+		{
+			name:    "Should fail - above max",
+			number:  Range(0, 120),
+			value:   121,
+			wantErr: errorcatalog.ErrAnswerNumberRange,
+		},
+		{
+			name:    "Should fail - step",
+			number:  Number{Step: 0.25},
+			value:   1.3,
+			wantErr: errorcatalog.ErrAnswerNumberStep,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := New[float64]("1", "Weight?", types.Decimal, WithNumber(tt.number))
+			assert.NoError(t, err)
+
+			err = q.Meta.Number.Check(tt.value, tt.integer)
+			if tt.wantErr != "" {
+				assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(tt.wantErr))
+
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+
+	_, err := New[float64]("1", "Weight?", types.Decimal, WithNumber(Range(2, 1)))
+	assert.Error(t, err)
+
+	_, err = New[float64]("1", "Weight?", types.Decimal, WithNumber(Number{Step: -1}))
+	assert.Error(t, err)
+}
+
+func TestDate_Check(t *testing.T) {
+	// Fixes "now".
+	now = func() time.Time { return time.Date(2024, 6, 15, 22, 30, 0, 0, time.UTC) }
+
+	defer func() { now = time.Now }()
+
+	tests := []struct {
+		name    string
+		typ     types.Type
+		date    Date
+		value   string
+		want    string
+		wantErr string
+	}{
+		{
+			name:  "Should work",
+			typ:   types.Date,
+			date:  Date{Min: "1900-01-01", Max: "now"},
+			value: "1990-05-20",
+		},
+		{
+			name:  "Should work - today in the location isn't in the future",
+			typ:   types.Date,
+			date:  Date{Location: "Asia/Tokyo", Max: "now"},
+			value: "2024-06-16",
+		},
+		{
+			name:  "Should work - date-time without offset",
+			typ:   types.DateTime,
+			date:  Date{Location: "America/Sao_Paulo", Max: "now"},
+			value: "2024-06-15T19:30:00",
+			want:  "2024-06-15T19:30:00-03:00",
+		},
+		{
+			name:  "Should work - range",
+			typ:   types.DateRange,
+			date:  Date{Min: "now-1mo", Max: "now+1w"},
+			value: "2024-06-01/2024-06-20",
+		},
+		{
+			name:    "Should fail - in the future",
+			typ:     types.Date,
+			date:    Date{Max: "now"},
+			value:   "2024-06-16",
+			wantErr: errorcatalog.ErrAnswerDateRange,
+		},
+		{
+			name:    "Should fail - relative min",
+			typ:     types.Date,
+			date:    Date{Max: "now-18y"},
+			value:   "2010-01-01",
+			wantErr: errorcatalog.ErrAnswerDateRange,
+		},
+		{
+			name:    "Should fail - date-time after max",
+			typ:     types.DateTime,
+			date:    Date{Max: "now+1h"},
+			value:   "2024-06-15T23:31:00Z",
+			wantErr: errorcatalog.ErrAnswerDateRange,
+		},
+		{
+			name:    "Should fail - reversed range",
+			typ:     types.DateRange,
+			value:   "2024-06-20/2024-06-01",
+			wantErr: errorcatalog.ErrAnswerDateInvalid,
+		},
+		{
+			name:    "Should fail - not RFC 3339",
+			typ:     types.Date,
+			value:   "20/05/1990",
+			wantErr: errorcatalog.ErrAnswerDateInvalid,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := New[string]("1", "When?", tt.typ, WithDate(tt.date))
+			assert.NoError(t, err)
+
+			dates, err := q.Meta.Date.Parse(tt.typ, tt.value)
+			if err == nil {
+				err = q.Meta.Date.Check(tt.typ, dates...)
+			}
+
+			if tt.wantErr != "" {
+				assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(tt.wantErr))
+
+				return
+			}
+
+			assert.NoError(t, err)
+
+			if tt.want == "" {
+				tt.want = tt.value
+			}
+
+			assert.Equal(t, tt.want, FormatDate(tt.typ, dates...))
 		})
 	}
+
+	_, err := New[string]("1", "When?", types.Date, WithDate(Date{Location: "Mars/Olympus"}))
+	assert.Error(t, err)
+
+	_, err = New[string]("1", "When?", types.Date, WithDate(Date{Min: "now", Max: "now-1d"}))
+	assert.Error(t, err)
+
+	_, err = New[string]("1", "When?", types.Date, WithDate(Date{Max: "tomorrow"}))
+	assert.Error(t, err)
+}
+
+func TestNewScale(t *testing.T) {
+	tests := []struct {
+		name       string
+		typ        types.Type
+		scale      Scale
+		wantValues []int
+		wantLabels []string
+		wantErr    bool
+	}{
+		{
+			name:       "Should work - Likert",
+			typ:        types.Likert,
+			scale:      Likert(5, "Strongly disagree", "Disagree", "Neutral", "Agree", "Strongly agree"),
+			wantValues: []int{1, 2, 3, 4, 5},
+			wantLabels: []string{"Strongly disagree", "Disagree", "Neutral", "Agree", "Strongly agree"},
+		},
+		{
+			name:       "Should work - rating",
+			typ:        types.Rating,
+			scale:      Rating(3),
+			wantValues: []int{1, 2, 3},
+			wantLabels: []string{"1", "2", "3"},
+		},
+		{
+			name:       "Should work - NPS",
+			typ:        types.NPS,
+			scale:      NPS(),
+			wantValues: []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			wantLabels: []string{"Not at all likely", "1", "2", "3", "4", "5", "6", "7", "8", "9", "Extremely likely"},
+		},
+		{
+			name:    "Should fail - not a scale type",
+			typ:     types.SingleSelect,
+			scale:   Rating(5),
+			wantErr: true,
+		},
+		{
+			name:    "Should fail - too many labels",
+			typ:     types.Likert,
+			scale:   Likert(2, "a", "b", "c"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := NewScale("1", "How much?", tt.typ, tt.scale, WithNextQuestionID("2"))
+			if tt.wantErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+
+			// Survives storage.
+			b, err := shared.Marshal(&q)
+			assert.NoError(t, err)
+
+			var loaded Question
+			assert.NoError(t, shared.Unmarshal(b, &loaded))
+			assert.Equal(t, tt.scale.Max, loaded.Meta.Scale.Max)
+
+			var (
+				values []int
+				labels []string
+			)
+
+			for _, id := range loaded.Options.Keys() {
+				opt, err := GetOption[int](loaded, id)
+				assert.NoError(t, err)
+				assert.Equal(t, "2", opt.NextQuestionID())
+				assert.Equal(t, opt.Value, opt.Weight)
+
+				values = append(values, opt.Value)
+				labels = append(labels, opt.Label)
+			}
+
+			assert.Equal(t, tt.wantValues, values)
+			assert.Equal(t, tt.wantLabels, labels)
+		})
+	}
+
+	reversed := Likert(5)
+	reversed.Reverse = true
+
+	assert.Equal(t, 5, reversed.Score(1))
+	assert.Equal(t, 0.75, reversed.Normalize(2))
+	assert.Equal(t, NPSDetractor, NPSCategory(6))
+	assert.Equal(t, NPSPassive, NPSCategory(8))
+	assert.Equal(t, NPSPromoter, NPSCategory(9))
+}
+
+func TestRanking_Check(t *testing.T) {
+	tests := []struct {
+		name    string
+		ranking Ranking
+		ids     []string
+		wantErr string
+	}{
+		{
+			name: "Should work",
+			ids:  []string{"c", "a", "b"},
+		},
+		{
+			name:    "Should work - top N",
+			ranking: Ranking{Top: 2},
+			ids:     []string{"c", "a"},
+		},
+		{
+			name:    "Should fail - duplicated",
+			ids:     []string{"c", "c", "b"},
+			wantErr: errorcatalog.ErrAnswerRankingDuplicate,
+		},
+		{
+			name:    "Should fail - incomplete",
+			ids:     []string{"c", "a"},
+			wantErr: errorcatalog.ErrAnswerRankingIncomplete,
+		},
+		{
+			name:    "Should fail - more than the top N",
+			ranking: Ranking{Top: 1},
+			ids:     []string{"c", "a"},
+			wantErr: errorcatalog.ErrAnswerRankingIncomplete,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.ranking.Check(tt.ids, 3)
+			if tt.wantErr != "" {
+				assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(tt.wantErr))
+
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+
+	assert.Equal(t, map[string]int{"c": 3, "a": 2, "b": 1}, Ranking{}.Points([]string{"c", "a", "b"}, 3))
+	assert.Equal(t, map[string]int{"c": 10, "a": 2}, Ranking{Weights: []int{10}}.Points([]string{"c", "a"}, 3))
+
+	_, err := New[string]("1", "Rank?", types.Ranking, WithRanking(Ranking{Top: -1}))
+	assert.Error(t, err)
+}
+
+func TestAttachment_Check(t *testing.T) {
+	tests := []struct {
+		name       string
+		attachment Attachment
+		size       int64
+		mimeType   string
+		wantErr    string
+	}{
+		{
+			name:       "Should work",
+			attachment: Attachment{MaxSize: 10, MIMETypes: []string{"application/pdf", "image/*"}},
+			size:       10,
+			mimeType:   "image/jpeg",
+		},
+		{
+			name:     "Should work - no constraints",
+			size:     1 << 30,
+			mimeType: "application/zip",
+		},
+		{
+			name:       "Should fail - too large",
+			attachment: Attachment{MaxSize: 10},
+			size:       11,
+			mimeType:   "image/jpeg",
+			wantErr:    errorcatalog.ErrAnswerAttachmentSize,
+		},
+		{
+			name:       "Should fail - type not allowed",
+			attachment: Attachment{MIMETypes: []string{"application/pdf", "image/*"}},
+			size:       1,
+			mimeType:   "text/html",
+			wantErr:    errorcatalog.ErrAnswerAttachmentType,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.attachment.Check(tt.size, tt.mimeType)
+			if tt.wantErr != "" {
+				assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(tt.wantErr))
+
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+
+	_, err := New[string]("1", "ID?", types.Attachment, WithAttachment(Attachment{MIMETypes: []string{"pdf"}}))
+	assert.Error(t, err)
 }
@@ -0,0 +1,98 @@
+package question
+
+import (
+	"fmt"
+
+	"github.com/thalesfsp/customerror"
+	"github.com/thalesfsp/questionnaire/errorcatalog"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// Ranking constraints of ranking questions. The respondent orders the
+// question's options, from the first position. The whole ranking is answered
+// at once, and routed by the question's default next question, or state - and
+// its rules.
+type Ranking struct {
+	// Top is the number of options to be ranked. Zero means all.
+	Top int `json:"top,omitempty" bson:"top,omitempty"`
+
+	// Weights are the points of each position, from the first one. Positions
+	// without weight are worth the number of options minus the position
+	// (Borda count), e.g.: 3, 2, 1 ranking three options.
+	Weights []int `json:"weights,omitempty" bson:"weights,omitempty"`
+}
+
+//////
+// Methods.
+//////
+
+// Check the ranked options (`ids`) against the constraints. `total` is the
+// number of options of the question. Empty rankings aren't checked - they're
+// governed by `Meta.Required`.
+func (r Ranking) Check(ids []string, total int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(ids))
+
+	for _, id := range ids {
+		if seen[id] {
+			return customerror.Wrap(
+				errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerRankingDuplicate),
+				fmt.Errorf("option %q", id),
+			)
+		}
+
+		seen[id] = true
+	}
+
+	want := total
+
+	if r.Top > 0 && r.Top < total {
+		want = r.Top
+	}
+
+	if len(ids) != want {
+		return customerror.Wrap(
+			errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerRankingIncomplete),
+			fmt.Errorf("%d of %d ranked", len(ids), want),
+		)
+	}
+
+	return nil
+}
+
+// Weight returns the points of the `position` - from zero, ranking `total`
+// options.
+func (r Ranking) Weight(position, total int) int {
+	if position < len(r.Weights) {
+		return r.Weights[position]
+	}
+
+	return total - position
+}
+
+// Points returns the points of each ranked option (`ids`), indexed by ID.
+// `total` is the number of options of the question.
+func (r Ranking) Points(ids []string, total int) map[string]int {
+	points := make(map[string]int, len(ids))
+
+	for i, id := range ids {
+		points[id] = r.Weight(i, total)
+	}
+
+	return points
+}
+
+// Validate the constraints.
+func (r Ranking) Validate() error {
+	if r.Top < 0 {
+		return customerror.NewInvalidError("ranking top")
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package question
+
+import (
+	"strconv"
+
+	"github.com/thalesfsp/customerror"
+	"github.com/thalesfsp/questionnaire/option"
+	"github.com/thalesfsp/questionnaire/types"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// NPS categories.
+const (
+	NPSDetractor = "detractor"
+	NPSPassive   = "passive"
+	NPSPromoter  = "promoter"
+)
+
+// Scale of ordinal questions, e.g.: Likert, rating, and NPS. Its points are
+// the integers from `Min` to `Max`.
+type Scale struct {
+	// Labels of the points, in order, starting from `Min`. Missing, or empty
+	// labels default to the point's value.
+	Labels []string `json:"labels,omitempty" bson:"labels,omitempty"`
+
+	// Max is the last point of the scale.
+	Max int `json:"max" bson:"max"`
+
+	// Min is the first point of the scale.
+	Min int `json:"min" bson:"min"`
+
+	// Reverse scores the scale from `Max` to `Min`, e.g.: negatively worded
+	// Likert items.
+	Reverse bool `json:"reverse,omitempty" bson:"reverse,omitempty"`
+}
+
+//////
+// Methods.
+//////
+
+// Points returns the number of points of the scale.
+func (s Scale) Points() int {
+	return s.Max - s.Min + 1
+}
+
+// Label returns the label of the point `v`.
+func (s Scale) Label(v int) string {
+	if i := v - s.Min; i >= 0 && i < len(s.Labels) && s.Labels[i] != "" {
+		return s.Labels[i]
+	}
+
+	return strconv.Itoa(v)
+}
+
+// Score returns the ordinal score of the point `v`, considering `Reverse`.
+func (s Scale) Score(v int) int {
+	if s.Reverse {
+		return s.Max + s.Min - v
+	}
+
+	return v
+}
+
+// Normalize returns the score of the point `v` in the [0, 1] range.
+func (s Scale) Normalize(v int) float64 {
+	return float64(s.Score(v)-s.Min) / float64(s.Max-s.Min)
+}
+
+// Validate the scale.
+func (s Scale) Validate() error {
+	if s.Max <= s.Min {
+		return customerror.NewInvalidError("scale bounds")
+	}
+
+	if len(s.Labels) > s.Points() {
+		return customerror.NewInvalidError("scale labels")
+	}
+
+	return nil
+}
+
+//////
+// Exported functionalities.
+//////
+
+// NPSCategory returns the Net Promoter Score category of the point `v`.
+func NPSCategory(v int) string {
+	switch {
+	case v >= 9:
+		return NPSPromoter
+	case v >= 7:
+		return NPSPassive
+	default:
+		return NPSDetractor
+	}
+}
+
+//////
+// Factory.
+//////
+
+// Likert creates a n-`points` Likert scale, starting from 1, with the
+// (anchors) `labels`, e.g.: "Strongly disagree", ..., "Strongly agree".
+func Likert(points int, labels ...string) Scale {
+	return Scale{Labels: labels, Max: points, Min: 1}
+}
+
+// Rating creates a star rating scale, from 1 to `stars`.
+func Rating(stars int) Scale {
+	return Scale{Max: stars, Min: 1}
+}
+
+// NPS creates a Net Promoter Score scale, from 0 to 10, with labelled anchors.
+func NPS() Scale {
+	labels := make([]string, 11)
+	labels[0] = "Not at all likely"
+	labels[10] = "Extremely likely"
+
+	return Scale{Labels: labels, Max: 10, Min: 0}
+}
+
+// NewScale creates a new scale question - Likert, rating, or NPS. Its options
+// are generated from the scale `s`: valued (int) from `Min` to `Max`, with
+// the point as ID, the scale's label, and the ordinal score as weight. They
+// route to the question's default next question, or state.
+func NewScale(
+	id string,
+	label string,
+	t types.Type,
+	s Scale,
+	params ...Func,
+) (Question, error) {
+	if !t.IsScale() {
+		return Question{}, customerror.NewInvalidError("scale type " + t.String())
+	}
+
+	return New[int](id, label, t, append(params, withScale(s))...)
+}
+
+// MustNewScale creates a new scale question and panics if there's an error.
+func MustNewScale(
+	id string,
+	label string,
+	t types.Type,
+	s Scale,
+	params ...Func,
+) Question {
+	q, err := NewScale(id, label, t, s, params...)
+	if err != nil {
+		panic(err)
+	}
+
+	return q
+}
+
+//////
+// Helpers.
+//////
+
+// withScale sets the scale, and generates its options. It must be the last
+// param, so the options follow the question's default route.
+func withScale(s Scale) Func {
+	return func(m *Meta) error {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+
+		opts := make([]option.Option[int], 0, s.Points())
+
+		for v := s.Min; v <= s.Max; v++ {
+			o, err := option.New(
+				v,
+				option.WithID(strconv.Itoa(v)),
+				option.WithLabel(s.Label(v)),
+				option.WithNextQuestionID(m.NextQuestionID),
+				option.WithState(m.State),
+				option.WithWeight(s.Score(v)),
+			)
+			if err != nil {
+				return err
+			}
+
+			opts = append(opts, o)
+		}
+
+		m.Scale = &s
+
+		return WithOption(opts...)(m)
+	}
+}
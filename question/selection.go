@@ -0,0 +1,57 @@
+package question
+
+import (
+	"fmt"
+
+	"github.com/thalesfsp/customerror"
+	"github.com/thalesfsp/questionnaire/errorcatalog"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// Selection constraints of multiple-select answers.
+//
+// NOTE: Branching. When the selected options route differently, the options'
+// rules are evaluated - in the question's options order, then the question's
+// ones. Without a matching rule, the first selected option - in the question's
+// options order - which routes (next question, or state) wins, otherwise the
+// question's default next question, or state.
+type Selection struct {
+	// Max is the maximum number of selected options. Zero means no limit.
+	Max int `json:"max,omitempty" bson:"max,omitempty"`
+
+	// Min is the minimum number of selected options.
+	Min int `json:"min,omitempty" bson:"min,omitempty"`
+}
+
+//////
+// Methods.
+//////
+
+// Check the number of selected options (`n`) against the constraints. No
+// selection isn't checked - it's governed by `Meta.Required`.
+func (s Selection) Check(n int) error {
+	if n == 0 {
+		return nil
+	}
+
+	if n < s.Min || (s.Max > 0 && n > s.Max) {
+		return customerror.Wrap(
+			errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerSelectionCount),
+			fmt.Errorf("%d selected", n),
+		)
+	}
+
+	return nil
+}
+
+// Validate the constraints.
+func (s Selection) Validate() error {
+	if s.Min < 0 || s.Max < 0 || (s.Max > 0 && s.Min > s.Max) {
+		return customerror.NewInvalidError("selection bounds")
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package question
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/thalesfsp/customerror"
+	"github.com/thalesfsp/questionnaire/errorcatalog"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// Text constraints of free-text answers.
+type Text struct {
+	// MaxLength is the maximum length, in characters. Zero means no limit.
+	MaxLength int `json:"maxLength,omitempty" bson:"maxLength,omitempty"`
+
+	// MinLength is the minimum length, in characters.
+	MinLength int `json:"minLength,omitempty" bson:"minLength,omitempty"`
+
+	// Pattern is a regular expression the text must match.
+	Pattern string `json:"pattern,omitempty" bson:"pattern,omitempty"`
+
+	// Trim leading, and trailing white spaces before validating.
+	Trim bool `json:"trim,omitempty" bson:"trim,omitempty"`
+}
+
+//////
+// Methods.
+//////
+
+// Normalize the text, e.g.: trimming.
+func (t Text) Normalize(text string) string {
+	if t.Trim {
+		return strings.TrimSpace(text)
+	}
+
+	return text
+}
+
+// Check the text against the constraints. Empty texts aren't checked - they
+// are governed by `Meta.Required`.
+func (t Text) Check(text string) error {
+	if text == "" {
+		return nil
+	}
+
+	length := utf8.RuneCountInString(text)
+
+	if length < t.MinLength || (t.MaxLength > 0 && length > t.MaxLength) {
+		return customerror.Wrap(
+			errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerTextLength),
+			fmt.Errorf("length %d", length),
+		)
+	}
+
+	if t.Pattern != "" {
+		re, err := regexp.Compile(t.Pattern)
+		if err != nil {
+			return customerror.NewInvalidError("pattern", customerror.WithError(err))
+		}
+
+		if !re.MatchString(text) {
+			return customerror.Wrap(
+				errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerTextPattern),
+				fmt.Errorf("pattern %s", t.Pattern),
+			)
+		}
+	}
+
+	return nil
+}
+
+// Validate the constraints.
+func (t Text) Validate() error {
+	if t.MinLength < 0 || t.MaxLength < 0 || (t.MaxLength > 0 && t.MinLength > t.MaxLength) {
+		return customerror.NewInvalidError("length bounds")
+	}
+
+	if _, err := regexp.Compile(t.Pattern); err != nil {
+		return customerror.NewInvalidError("pattern", customerror.WithError(err))
+	}
+
+	return nil
+}
@@ -0,0 +1,470 @@
+package questionnaire
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/thalesfsp/customerror"
+	"github.com/thalesfsp/questionnaire/errorcatalog"
+	"github.com/thalesfsp/questionnaire/option"
+	"github.com/thalesfsp/questionnaire/question"
+	"github.com/thalesfsp/questionnaire/rule"
+	"github.com/thalesfsp/questionnaire/types"
+	"github.com/thalesfsp/status"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// IssueType is the type of an issue found analyzing a questionnaire.
+type IssueType string
+
+const (
+	// IssueCycle is a cycle of questions without a way out.
+	IssueCycle IssueType = "cycle"
+
+	// IssueDangling is a reference to an unknown question.
+	IssueDangling IssueType = "dangling"
+
+	// IssueNoOptions is a question without options.
+	IssueNoOptions IssueType = "no-options"
+
+	// IssueNoRoute is an option - or a question accepting values, a matrix, or
+	// a ranking - which neither routes, nor sets a state.
+	IssueNoRoute IssueType = "no-route"
+
+	// IssueUnreachable is a question unreachable from the first question.
+	IssueUnreachable IssueType = "unreachable"
+)
+
+// Issue found analyzing a questionnaire.
+type Issue struct {
+	// Type of the issue.
+	Type IssueType `json:"type" bson:"type"`
+
+	// QuestionID is the ID of the question where the issue was found.
+	QuestionID string `json:"questionID" bson:"questionID"`
+
+	// OptionID is the ID of the option where the issue was found, if any.
+	OptionID string `json:"optionID,omitempty" bson:"optionID,omitempty"`
+
+	// Message describes the issue.
+	Message string `json:"message" bson:"message"`
+}
+
+// Report is the result of the analysis of a questionnaire.
+type Report struct {
+	// Graph is the directed graph of the questionnaire. Keys are question IDs,
+	// values are the IDs of the questions they can lead to.
+	Graph map[string][]string `json:"graph" bson:"graph"`
+
+	// Issues found.
+	Issues []Issue `json:"issues" bson:"issues"`
+}
+
+// node is a question in the graph.
+type node struct {
+	// edges are the IDs of the questions it can lead to.
+	edges []string
+
+	// terminal is true if the question can end the questionnaire.
+	terminal bool
+}
+
+//////
+// Methods.
+//////
+
+// String implements the Stringer interface.
+func (t IssueType) String() string {
+	return string(t)
+}
+
+// Error implements the error interface.
+func (i Issue) Error() string {
+	return i.Message
+}
+
+// Valid returns true if there are no issues.
+func (r Report) Valid() bool {
+	return len(r.Issues) == 0
+}
+
+// Analyze builds the directed graph from every option's, and question's
+// routing - static, and rules - reporting issues which would leave a
+// respondent stuck.
+func (q *Questionnaire) Analyze() Report {
+	r := Report{
+		Graph:  map[string][]string{},
+		Issues: []Issue{},
+	}
+
+	if q.Questions == nil || q.Questions.Empty() {
+		return r
+	}
+
+	questions := q.Questions.Values()
+	nodes := make(map[string]*node, len(questions))
+
+	//////
+	// Build the graph.
+	//////
+
+	// Questions of a page lead to the next one of the page, the machine only
+	// follows the routing of its last question.
+	paged := map[string]string{}
+
+	for _, p := range q.Pages {
+		for i := 0; i < len(p.QuestionIDs)-1; i++ {
+			paged[p.QuestionIDs[i]] = p.QuestionIDs[i+1]
+		}
+	}
+
+	for _, qst := range questions {
+		if next, ok := paged[qst.GetID()]; ok {
+			nodes[qst.GetID()] = &node{edges: []string{next}}
+			r.Graph[qst.GetID()] = []string{next}
+
+			continue
+		}
+
+		n, issues := analyzeQuestion(q, qst)
+
+		nodes[qst.GetID()] = n
+		r.Graph[qst.GetID()] = n.edges
+		r.Issues = append(r.Issues, issues...)
+	}
+
+	//////
+	// Reachability.
+	//////
+
+	_, first, _ := q.Questions.First()
+
+	reachable := map[string]bool{first.GetID(): true}
+	queue := []string{first.GetID()}
+
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		for _, next := range nodes[id].edges {
+			if !reachable[next] {
+				reachable[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	for _, qst := range questions {
+		if !reachable[qst.GetID()] {
+			r.Issues = append(r.Issues, Issue{
+				Type:       IssueUnreachable,
+				QuestionID: qst.GetID(),
+				Message:    fmt.Sprintf("question %s is unreachable from the first question", qst.GetID()),
+			})
+		}
+	}
+
+	//////
+	// Cycles without a way out.
+	//////
+
+	for _, component := range stronglyConnected(q.Questions.Keys(), nodes) {
+		if isTrap(component, nodes) {
+			r.Issues = append(r.Issues, Issue{
+				Type:       IssueCycle,
+				QuestionID: component[0],
+				Message:    fmt.Sprintf("questions %s form a cycle without a way out", strings.Join(component, ", ")),
+			})
+		}
+	}
+
+	return r
+}
+
+// Validate the questionnaire graph. See `Analyze`.
+func (q *Questionnaire) Validate() error {
+	r := q.Analyze()
+
+	if r.Valid() {
+		return nil
+	}
+
+	errs := make([]error, 0, len(r.Issues))
+
+	for _, i := range r.Issues {
+		errs = append(errs, i)
+	}
+
+	return customerror.Wrap(errorcatalog.Catalog.MustGet(errorcatalog.ErrQuestionnaireInvalid), errs...)
+}
+
+//////
+// Helpers.
+//////
+
+// analyzeQuestion returns the node of the question, and its issues.
+func analyzeQuestion(q *Questionnaire, qst question.Question) (*node, []Issue) {
+	n := &node{edges: []string{}}
+	issues := []Issue{}
+	seen := map[string]bool{}
+
+	addEdge := func(optionID, id string) {
+		if id == "" || seen[id] {
+			return
+		}
+
+		if !q.Questions.Contains(id) {
+			issues = append(issues, Issue{
+				Type:       IssueDangling,
+				QuestionID: qst.GetID(),
+				OptionID:   optionID,
+				Message:    fmt.Sprintf("question %s refers to the unknown question %s", qst.GetID(), id),
+			})
+
+			return
+		}
+
+		seen[id] = true
+		n.edges = append(n.edges, id)
+	}
+
+	addRules := func(optionID string, rules []rule.Rule) {
+		for _, r := range rules {
+			addEdge(optionID, r.NextQuestionID)
+
+			if r.NextQuestionID == "" && isState(r.State) {
+				n.terminal = true
+			}
+		}
+	}
+
+	//////
+	// Question-level routing.
+	//////
+
+	addEdge("", qst.Meta.NextQuestionID)
+	addRules("", qst.Meta.Rules)
+
+	if isState(qst.Meta.State) {
+		n.terminal = true
+	}
+
+	// A rule without conditions always matches, so all options are routed.
+	// Multiple-select questions fall back to the question's default routing.
+	fallback := qst.Type == types.MultipleSelect && (qst.Meta.NextQuestionID != "" || isState(qst.Meta.State))
+
+	for _, r := range qst.Meta.Rules {
+		if len(r.Conditions) == 0 {
+			fallback = true
+		}
+	}
+
+	//////
+	// Respondent-provided values, matrices, and rankings, routed by the
+	// question.
+	//////
+
+	// Options of matrices (columns), and rankings (items) don't route.
+	items := qst.Type == types.Matrix || qst.Type == types.Ranking
+
+	hasOptions := qst.Options != nil && !qst.Options.Empty()
+
+	// Questions accepting values, with options - e.g.: text ones routed by
+	// their options, fall back to the question's default routing.
+	if qst.Type.IsInput() && hasOptions && (qst.Meta.NextQuestionID != "" || isState(qst.Meta.State)) {
+		fallback = true
+	}
+
+	routedByQuestion := (qst.Type.IsInput() && !hasOptions) || items
+
+	if routedByQuestion && qst.Meta.NextQuestionID == "" && !isState(qst.Meta.State) && !fallback {
+		issues = append(issues, Issue{
+			Type:       IssueNoRoute,
+			QuestionID: qst.GetID(),
+			Message:    fmt.Sprintf("question %s accepts values, but neither routes, nor sets a state", qst.GetID()),
+		})
+	}
+
+	if qst.Type.IsInput() && !hasOptions {
+		return n, issues
+	}
+
+	//////
+	// Options.
+	//////
+
+	if !hasOptions {
+		issues = append(issues, Issue{
+			Type:       IssueNoOptions,
+			QuestionID: qst.GetID(),
+			Message:    fmt.Sprintf("question %s has no options", qst.GetID()),
+		})
+
+		return n, issues
+	}
+
+	if items {
+		return n, issues
+	}
+
+	for _, v := range qst.Options.Values() {
+		opt, err := option.ToOption[any](v)
+		if err != nil {
+			continue
+		}
+
+		addEdge(opt.GetID(), opt.NextQuestionID())
+		addRules(opt.GetID(), opt.GetRules())
+
+		if opt.NextQuestionID() == "" && isState(opt.GetState()) {
+			n.terminal = true
+		}
+
+		routed := opt.NextQuestionID() != "" || isState(opt.GetState()) || fallback
+
+		for _, r := range opt.GetRules() {
+			if len(r.Conditions) == 0 {
+				routed = true
+			}
+		}
+
+		if !routed {
+			issues = append(issues, Issue{
+				Type:       IssueNoRoute,
+				QuestionID: qst.GetID(),
+				OptionID:   opt.GetID(),
+				Message:    fmt.Sprintf("option %s of question %s neither routes, nor sets a state", opt.GetID(), qst.GetID()),
+			})
+		}
+	}
+
+	return n, issues
+}
+
+// isState returns true if `s` is set.
+func isState(s status.Status) bool {
+	return s != "" && s != status.None
+}
+
+// isTrap returns true if the strongly connected `component` is a cycle which
+// can't be left: no question ends the questionnaire, or leads outside of it.
+func isTrap(component []string, nodes map[string]*node) bool {
+	members := make(map[string]bool, len(component))
+
+	for _, id := range component {
+		members[id] = true
+	}
+
+	// A single question is a cycle only if it leads to itself.
+	if len(component) == 1 {
+		selfLoop := false
+
+		for _, next := range nodes[component[0]].edges {
+			if next == component[0] {
+				selfLoop = true
+			}
+		}
+
+		if !selfLoop {
+			return false
+		}
+	}
+
+	for _, id := range component {
+		if nodes[id].terminal {
+			return false
+		}
+
+		for _, next := range nodes[id].edges {
+			if !members[next] {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// stronglyConnected returns the strongly connected components of the graph
+// (Tarjan's algorithm), each in the questionnaire's order.
+func stronglyConnected(ids []string, nodes map[string]*node) [][]string {
+	index := 0
+	indexes := map[string]int{}
+	lowLinks := map[string]int{}
+	onStack := map[string]bool{}
+	stack := []string{}
+	components := [][]string{}
+
+	order := make(map[string]int, len(ids))
+
+	for i, id := range ids {
+		order[id] = i
+	}
+
+	var connect func(id string)
+
+	connect = func(id string) {
+		indexes[id] = index
+		lowLinks[id] = index
+		index++
+
+		stack = append(stack, id)
+		onStack[id] = true
+
+		for _, next := range nodes[id].edges {
+			if _, visited := indexes[next]; !visited {
+				connect(next)
+
+				lowLinks[id] = minInt(lowLinks[id], lowLinks[next])
+			} else if onStack[next] {
+				lowLinks[id] = minInt(lowLinks[id], indexes[next])
+			}
+		}
+
+		if lowLinks[id] != indexes[id] {
+			return
+		}
+
+		component := []string{}
+
+		for {
+			last := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[last] = false
+
+			component = append(component, last)
+
+			if last == id {
+				break
+			}
+		}
+
+		// Keeps the questionnaire's order.
+		sort.Slice(component, func(i, j int) bool {
+			return order[component[i]] < order[component[j]]
+		})
+
+		components = append(components, component)
+	}
+
+	for _, id := range ids {
+		if _, visited := indexes[id]; !visited {
+			connect(id)
+		}
+	}
+
+	return components
+}
+
+// minInt returns the smallest of `a`, and `b`.
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,305 @@
+package questionnaire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thalesfsp/questionnaire/errorcatalog"
+	"github.com/thalesfsp/questionnaire/internal/shared"
+	"github.com/thalesfsp/questionnaire/option"
+	"github.com/thalesfsp/questionnaire/question"
+	"github.com/thalesfsp/questionnaire/rule"
+	"github.com/thalesfsp/questionnaire/types"
+	"github.com/thalesfsp/status"
+)
+
+func TestQuestionnaire_Analyze(t *testing.T) {
+	done := option.MustNew(true, option.WithState(status.Completed))
+
+	tests := []struct {
+		name      string
+		questions []question.Question
+		pages     []Page
+		want      []IssueType
+	}{
+		{
+			name: "Should work",
+			questions: []question.Question{
+				question.MustNew[string]("1", "Q1", types.SingleSelect, question.WithOption(
+					option.MustNew("a", option.WithNextQuestionID("2")),
+					option.MustNew("b", option.WithRule(rule.GoTo("3", rule.If("1", rule.Equal, "b")), rule.GoTo("2"))),
+				)),
+				question.MustNew[string]("2", "Q2", types.SingleSelect, question.WithOption(
+					option.MustNew("a", option.WithNextQuestionID("1")),
+					option.MustNew("b", option.WithNextQuestionID("3")),
+				)),
+				question.MustNew[bool]("3", "Q3", types.SingleSelect, question.WithOption(done)),
+			},
+			want: []IssueType{},
+		},
+		{
+			name: "Should report dangling",
+			questions: []question.Question{
+				question.MustNew[string]("1", "Q1", types.SingleSelect, question.WithOption(
+					option.MustNew("a", option.WithNextQuestionID("2")),
+					option.MustNew("b", option.WithNextQuestionID("404")),
+				)),
+				question.MustNew[bool]("2", "Q2", types.SingleSelect, question.WithOption(done)),
+			},
+			want: []IssueType{IssueDangling},
+		},
+		{
+			name: "Should report unreachable",
+			questions: []question.Question{
+				question.MustNew[bool]("1", "Q1", types.SingleSelect, question.WithOption(done)),
+				question.MustNew[bool]("2", "Q2", types.SingleSelect, question.WithOption(done)),
+			},
+			want: []IssueType{IssueUnreachable},
+		},
+		{
+			name: "Should report cycles without a way out",
+			questions: []question.Question{
+				question.MustNew[string]("1", "Q1", types.SingleSelect, question.WithOption(
+					option.MustNew("a", option.WithNextQuestionID("2")),
+					option.MustNew("b", option.WithNextQuestionID("4")),
+				)),
+				question.MustNew[string]("2", "Q2", types.SingleSelect, question.WithOption(
+					option.MustNew("a", option.WithNextQuestionID("3")),
+				)),
+				question.MustNew[string]("3", "Q3", types.SingleSelect, question.WithOption(
+					option.MustNew("a", option.WithNextQuestionID("2")),
+				)),
+				question.MustNew[bool]("4", "Q4", types.SingleSelect, question.WithOption(done)),
+			},
+			want: []IssueType{IssueCycle},
+		},
+		{
+			name: "Should report options without route, and questions without options",
+			questions: []question.Question{
+				question.MustNew[string]("1", "Q1", types.SingleSelect, question.WithOption(
+					option.MustNew("a", option.WithNextQuestionID("2")),
+					option.MustNew("b"),
+				)),
+				question.MustNew[string]("2", "Q2", types.SingleSelect, question.WithState(status.Completed)),
+			},
+			want: []IssueType{IssueNoRoute, IssueNoOptions},
+		},
+		{
+			name: "Should work - questions accepting values don't need options",
+			questions: []question.Question{
+				question.MustNew[string]("1", "Q1", types.Text, question.WithNextQuestionID("2")),
+				question.MustNew[string]("2", "Q2", types.Text, question.WithState(status.Completed)),
+			},
+			want: []IssueType{},
+		},
+		{
+			name: "Should work - multiple-select falls back to the question's routing",
+			questions: []question.Question{
+				question.MustNew[string]("1", "Q1", types.MultipleSelect,
+					question.WithOption(option.MustNew("a"), option.MustNew("b", option.WithNextQuestionID("1"))),
+					question.WithState(status.Completed),
+				),
+			},
+			want: []IssueType{},
+		},
+		{
+			name: "Should report matrices without route, nor columns",
+			questions: []question.Question{
+				question.MustNew[int]("1", "Q1", types.Matrix,
+					question.WithMatrix(question.Matrix{Rows: []question.Row{{ID: "price"}}}),
+				),
+			},
+			want: []IssueType{IssueNoRoute, IssueNoOptions},
+		},
+		{
+			name: "Should work - pages follow the routing of their last question",
+			questions: []question.Question{
+				question.MustNew[string]("1", "Q1", types.Text),
+				question.MustNew[string]("2", "Q2", types.Text, question.WithNextQuestionID("3")),
+				question.MustNew[string]("3", "Q3", types.Text, question.WithState(status.Completed)),
+			},
+			pages: []Page{{ID: "p1", QuestionIDs: []string{"1", "2"}}},
+			want:  []IssueType{},
+		},
+		{
+			name: "Should work - questions accepting values routed by their options",
+			questions: []question.Question{
+				question.MustNew[string]("1", "Q1", types.Text, question.WithOption(
+					option.MustNew("a", option.WithNextQuestionID("2")),
+					option.MustNew("b", option.WithState(status.Completed)),
+				)),
+				question.MustNew[string]("2", "Q2", types.Text, question.WithOption(
+					option.MustNew("a"),
+				), question.WithState(status.Completed)),
+			},
+			want: []IssueType{},
+		},
+		{
+			name: "Should report options of questions accepting values without route",
+			questions: []question.Question{
+				question.MustNew[string]("1", "Q1", types.Text, question.WithOption(
+					option.MustNew("a", option.WithState(status.Completed)),
+					option.MustNew("b"),
+				)),
+			},
+			want: []IssueType{IssueNoRoute},
+		},
+		{
+			name: "Should report questions accepting values without route",
+			questions: []question.Question{
+				question.MustNew[string]("1", "Q1", types.Text),
+			},
+			want: []IssueType{IssueNoRoute},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := NewWithParams("Analyze", tt.questions, WithPage(tt.pages...))
+			assert.NoError(t, err)
+
+			got := []IssueType{}
+
+			for _, i := range q.Analyze().Issues {
+				got = append(got, i.Type)
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			_, err = NewWithParams("Analyze", tt.questions, WithPage(tt.pages...), WithValidation(true))
+
+			if len(tt.want) == 0 {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrQuestionnaireInvalid))
+			}
+		})
+	}
+}
+
+func TestNewWithParams_pages(t *testing.T) {
+	q1 := question.MustNew[string]("1", "Q1", types.Text, question.WithNextQuestionID("2"))
+	q2 := question.MustNew[string]("2", "Q2", types.Text, question.WithState(status.Completed))
+
+	tests := []struct {
+		name    string
+		pages   []Page
+		wantErr bool
+	}{
+		{
+			name:  "Should work",
+			pages: []Page{{ID: "p1", QuestionIDs: []string{"1"}}, {ID: "p2", QuestionIDs: []string{"2"}}},
+		},
+		{
+			name:    "Should fail - duplicated page",
+			pages:   []Page{{ID: "p1", QuestionIDs: []string{"1"}}, {ID: "p1", QuestionIDs: []string{"2"}}},
+			wantErr: true,
+		},
+		{
+			name:    "Should fail - unknown question",
+			pages:   []Page{{ID: "p1", QuestionIDs: []string{"1", "404"}}},
+			wantErr: true,
+		},
+		{
+			name:    "Should fail - question part of several pages",
+			pages:   []Page{{ID: "p1", QuestionIDs: []string{"1", "2"}}, {ID: "p2", QuestionIDs: []string{"2"}}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := NewWithParams("Pages", []question.Question{q1, q2}, WithPage(tt.pages...))
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrQuestionnaireInvalidPage))
+
+				return
+			}
+
+			assert.NoError(t, err)
+
+			p, ok := q.PageOf("2")
+			assert.True(t, ok)
+			assert.Equal(t, "p2", p.ID)
+
+			_, ok = q.Page("p3")
+			assert.False(t, ok)
+
+			// Survives storage.
+			b, err := shared.Marshal(q)
+			assert.NoError(t, err)
+
+			var loaded Questionnaire
+
+			assert.NoError(t, shared.Unmarshal(b, &loaded))
+			assert.Equal(t, q.Pages, loaded.Pages)
+		})
+	}
+}
+
+func TestNewWithParams_outcomes(t *testing.T) {
+	q1 := question.MustNew[string]("1", "Q1", types.Text, question.WithNextQuestionID("2"))
+	q2 := question.MustNew[string]("2", "Q2", types.Text, question.WithState(status.Completed))
+
+	tests := []struct {
+		name     string
+		outcomes []Outcome
+		wantErr  bool
+	}{
+		{
+			name: "Should work",
+			outcomes: []Outcome{
+				{ID: "expert", Label: "Expert", Conditions: []rule.Condition{rule.If(ScoreTotal, rule.GreaterThan, 10.0)}},
+				{ID: "fan", Label: "Fan", Conditions: []rule.Condition{rule.If("1", rule.Equal, "go"), rule.If(ScoreSection("p1"), rule.Equal, 100.0)}},
+				{ID: "beginner", Label: "Beginner"},
+			},
+		},
+		{
+			name:     "Should fail - duplicated outcome",
+			outcomes: []Outcome{{ID: "beginner"}, {ID: "beginner"}},
+			wantErr:  true,
+		},
+		{
+			name:     "Should fail - unknown question",
+			outcomes: []Outcome{{ID: "expert", Conditions: []rule.Condition{rule.If("404", rule.Answered, nil)}}},
+			wantErr:  true,
+		},
+		{
+			name:     "Should fail - unknown page",
+			outcomes: []Outcome{{ID: "expert", Conditions: []rule.Condition{rule.If(ScoreSection("p2"), rule.Equal, 100)}}},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := NewWithParams(
+				"Outcomes",
+				[]question.Question{q1, q2},
+				WithPage(Page{ID: "p1", QuestionIDs: []string{"1"}}),
+				WithOutcome(tt.outcomes...),
+			)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrQuestionnaireInvalidOutcome))
+
+				return
+			}
+
+			assert.NoError(t, err)
+
+			o, ok := q.Classify(func(id string) (any, bool) {
+				return map[string]any{"1": "go", ScoreTotal: 3.0, ScoreSection("p1"): 100.0}[id], true
+			})
+			assert.True(t, ok)
+			assert.Equal(t, "fan", o.ID)
+
+			// Survives storage.
+			b, err := shared.Marshal(q)
+			assert.NoError(t, err)
+
+			var loaded Questionnaire
+
+			assert.NoError(t, shared.Unmarshal(b, &loaded))
+			assert.Equal(t, q.Outcomes, loaded.Outcomes)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package questionnaire
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/thalesfsp/go-common-types/safeorderedmap"
+	"github.com/thalesfsp/questionnaire/common"
+	"github.com/thalesfsp/questionnaire/internal/shared"
+	"github.com/thalesfsp/questionnaire/question"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// questionnaireAlias is Questionnaire without its methods, avoiding infinite
+// recursion while unmarshalling.
+type questionnaireAlias Questionnaire
+
+// document is how a Questionnaire is persisted in BSON. Questions are stored
+// as an ordered list.
+type document struct {
+	common.Common `bson:",inline"`
+
+	// Hash is a hash based on SHA-256.
+	Hash string `bson:"hash"`
+
+	// Outcomes the session is classified as.
+	Outcomes []Outcome `bson:"outcomes,omitempty"`
+
+	// Pages group questions answered together.
+	Pages []Page `bson:"pages,omitempty"`
+
+	// Questions is the ordered list of questions.
+	Questions []question.Question `bson:"questions"`
+
+	// Quiz settings of assessments.
+	Quiz *Quiz `bson:"quiz,omitempty"`
+
+	// Title of the questionnaire.
+	Title string `bson:"title"`
+}
+
+//////
+// Helpers.
+//////
+
+// sortQuestions restores the order of the questions based on their index.
+// Order matters, e.g.: the first question is where the machine starts.
+func sortQuestions(questions []question.Question) *safeorderedmap.SafeOrderedMap[question.Question] {
+	sort.SliceStable(questions, func(i, j int) bool {
+		return questions[i].GetIndex() < questions[j].GetIndex()
+	})
+
+	questionMap := safeorderedmap.New[question.Question]()
+
+	for _, q := range questions {
+		questionMap.Add(q.GetID(), q)
+	}
+
+	return questionMap
+}
+
+//////
+// Serialization.
+//////
+
+// UnmarshalJSON implements the json.Unmarshaler interface. JSON objects are
+// unordered, so the order of the questions is restored.
+func (q *Questionnaire) UnmarshalJSON(data []byte) error {
+	var a questionnaireAlias
+
+	if err := json.Unmarshal(data, &a); err != nil {
+		return err
+	}
+
+	if a.Questions != nil {
+		a.Questions = sortQuestions(a.Questions.Values())
+	}
+
+	*q = Questionnaire(a)
+
+	return nil
+}
+
+// MarshalBSON implements the bson.Marshaler interface.
+func (q Questionnaire) MarshalBSON() ([]byte, error) {
+	d := document{
+		Common:    q.Common,
+		Hash:      q.Hash,
+		Outcomes:  q.Outcomes,
+		Pages:     q.Pages,
+		Questions: []question.Question{},
+		Quiz:      q.Quiz,
+		Title:     q.Title,
+	}
+
+	if q.Questions != nil {
+		d.Questions = q.Questions.Values()
+	}
+
+	return shared.MarshalBSON(d)
+}
+
+// UnmarshalBSON implements the bson.Unmarshaler interface.
+func (q *Questionnaire) UnmarshalBSON(data []byte) error {
+	var d document
+
+	if err := shared.UnmarshalBSON(data, &d); err != nil {
+		return err
+	}
+
+	q.Common = d.Common
+	q.Hash = d.Hash
+	q.Outcomes = d.Outcomes
+	q.Pages = d.Pages
+	q.Quiz = d.Quiz
+	q.Questions = sortQuestions(d.Questions)
+	q.Title = d.Title
+
+	return nil
+}
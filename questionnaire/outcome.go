@@ -0,0 +1,183 @@
+package questionnaire
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/thalesfsp/customerror"
+	"github.com/thalesfsp/questionnaire/errorcatalog"
+	"github.com/thalesfsp/questionnaire/question"
+	"github.com/thalesfsp/questionnaire/rule"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// Score references. Outcome conditions use them as question IDs to compare
+// the session's score, e.g.: `rule.If(questionnaire.ScoreTotal, rule.GreaterThan, 10)`.
+const (
+	ScoreAverage    = "$score.average"
+	ScoreBand       = "$score.band"
+	ScoreNormalized = "$score.normalized"
+	ScoreTotal      = "$score.total"
+
+	// scoreSectionPrefix prefixes the references to the normalized score of
+	// pages. See `ScoreSection`.
+	scoreSectionPrefix = "$score.section."
+)
+
+// Outcome is a result the session is classified as, once finished, e.g.:
+// "Beginner", "Intermediate", or "Expert". Outcomes are evaluated in order,
+// the first one which all conditions - against the answers, and the score,
+// are met is chosen. An outcome without conditions always matches, which is
+// useful as the last - "else" - one.
+type Outcome struct {
+	// ID of the outcome.
+	ID string `json:"id" bson:"id"`
+
+	// Conditions to be met (AND). Score references - e.g.: `ScoreTotal`, are
+	// accepted as question IDs.
+	Conditions []rule.Condition `json:"conditions,omitempty" bson:"conditions,omitempty"`
+
+	// Description of the outcome.
+	Description string `json:"description,omitempty" bson:"description,omitempty"`
+
+	// Label of the outcome.
+	Label string `json:"label" bson:"label"`
+}
+
+//////
+// Methods.
+//////
+
+// Match returns true if the answers, and the score - see `rule.Lookup`,
+// satisfy all conditions.
+func (o Outcome) Match(lookup rule.Lookup) bool {
+	return rule.Rule{Conditions: o.Conditions}.Match(lookup)
+}
+
+// Validate the outcome.
+func (o Outcome) Validate() error {
+	if o.ID == "" {
+		return customerror.NewRequiredError("outcome ID")
+	}
+
+	for _, c := range o.Conditions {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Outcome returns the outcome `id`.
+func (q *Questionnaire) Outcome(id string) (Outcome, bool) {
+	for _, o := range q.Outcomes {
+		if o.ID == id {
+			return o, true
+		}
+	}
+
+	return Outcome{}, false
+}
+
+// Classify returns the first outcome matching the answers, and the score. `ok`
+// is false if none matches.
+func (q *Questionnaire) Classify(lookup rule.Lookup) (Outcome, bool) {
+	for _, o := range q.Outcomes {
+		if o.Match(lookup) {
+			return o, true
+		}
+	}
+
+	return Outcome{}, false
+}
+
+//////
+// Exported functionalities.
+//////
+
+// ScoreSection returns the reference to the normalized score of the page
+// `pageID`.
+func ScoreSection(pageID string) string {
+	return scoreSectionPrefix + pageID
+}
+
+// IsScoreRef returns true if `id` references the score. See `ScoreTotal`.
+func IsScoreRef(id string) bool {
+	return strings.HasPrefix(id, "$score.")
+}
+
+// SectionOf returns the page ID of the score reference `id`. `ok` is false if
+// it doesn't reference a page. See `ScoreSection`.
+func SectionOf(id string) (string, bool) {
+	if !strings.HasPrefix(id, scoreSectionPrefix) {
+		return "", false
+	}
+
+	return strings.TrimPrefix(id, scoreSectionPrefix), true
+}
+
+//////
+// Helpers.
+//////
+
+// validateOutcomes checks outcomes have unique IDs, and their conditions refer
+// to known questions, matrix rows, pages, or score references.
+func (q *Questionnaire) validateOutcomes() error {
+	outcomes := map[string]bool{}
+
+	for _, o := range q.Outcomes {
+		if err := o.Validate(); err != nil {
+			return err
+		}
+
+		if outcomes[o.ID] {
+			return customerror.Wrap(
+				errorcatalog.Catalog.MustGet(errorcatalog.ErrQuestionnaireInvalidOutcome),
+				fmt.Errorf("outcome %s is duplicated", o.ID),
+			)
+		}
+
+		outcomes[o.ID] = true
+
+		for _, c := range o.Conditions {
+			if !q.knows(c.QuestionID) {
+				return customerror.Wrap(
+					errorcatalog.Catalog.MustGet(errorcatalog.ErrQuestionnaireInvalidOutcome),
+					fmt.Errorf("outcome %s refers to the unknown %s", o.ID, c.QuestionID),
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+// knows returns true if `id` refers to a question, a matrix row, or the score.
+func (q *Questionnaire) knows(id string) bool {
+	if pageID, ok := SectionOf(id); ok {
+		_, ok := q.Page(pageID)
+
+		return ok
+	}
+
+	switch id {
+	case ScoreAverage, ScoreBand, ScoreNormalized, ScoreTotal:
+		return true
+	}
+
+	if q.Questions == nil {
+		return false
+	}
+
+	if q.Questions.Contains(id) {
+		return true
+	}
+
+	qstID, _, found := strings.Cut(id, question.RowSeparator)
+
+	return found && q.Questions.Contains(qstID)
+}
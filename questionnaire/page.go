@@ -0,0 +1,141 @@
+package questionnaire
+
+import (
+	"fmt"
+
+	"github.com/thalesfsp/customerror"
+	"github.com/thalesfsp/questionnaire/errorcatalog"
+	"github.com/thalesfsp/questionnaire/question"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// Page groups questions displayed, and submitted together, e.g.: web forms.
+// Its questions are validated as a unit, and recorded in order. Branching
+// happens at page boundaries: the machine follows the routing of the page's
+// last question - its rules see all of the page's answers.
+type Page struct {
+	// ID of the page.
+	ID string `json:"id" bson:"id"`
+
+	// QuestionIDs are the IDs of the questions of the page, in order.
+	QuestionIDs []string `json:"questionIDs" bson:"questionIDs"`
+
+	// Title of the page.
+	Title string `json:"title,omitempty" bson:"title,omitempty"`
+}
+
+//////
+// Methods.
+//////
+
+// Contains returns true if the question `questionID` is part of the page.
+func (p Page) Contains(questionID string) bool {
+	for _, id := range p.QuestionIDs {
+		if id == questionID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate the page.
+func (p Page) Validate() error {
+	if p.ID == "" {
+		return customerror.NewRequiredError("page ID")
+	}
+
+	if len(p.QuestionIDs) == 0 {
+		return customerror.NewRequiredError("questions of page " + p.ID)
+	}
+
+	return nil
+}
+
+// Page returns the page `id`.
+func (q *Questionnaire) Page(id string) (Page, bool) {
+	for _, p := range q.Pages {
+		if p.ID == id {
+			return p, true
+		}
+	}
+
+	return Page{}, false
+}
+
+// PageOf returns the page of the question `questionID`, if it's part of one.
+func (q *Questionnaire) PageOf(questionID string) (Page, bool) {
+	for _, p := range q.Pages {
+		if p.Contains(questionID) {
+			return p, true
+		}
+	}
+
+	return Page{}, false
+}
+
+// PageQuestions returns the questions of the page `p`, in order.
+func (q *Questionnaire) PageQuestions(p Page) []question.Question {
+	questions := make([]question.Question, 0, len(p.QuestionIDs))
+
+	if q.Questions == nil {
+		return questions
+	}
+
+	for _, id := range p.QuestionIDs {
+		if qst, ok := q.Questions.Get(id); ok {
+			questions = append(questions, qst)
+		}
+	}
+
+	return questions
+}
+
+//////
+// Helpers.
+//////
+
+// validatePages checks pages have unique IDs, and refer to known questions,
+// each one part of a page at most.
+func (q *Questionnaire) validatePages() error {
+	pages := map[string]bool{}
+	paged := map[string]string{}
+
+	for _, p := range q.Pages {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+
+		if pages[p.ID] {
+			return customerror.Wrap(
+				errorcatalog.Catalog.MustGet(errorcatalog.ErrQuestionnaireInvalidPage),
+				fmt.Errorf("page %s is duplicated", p.ID),
+			)
+		}
+
+		pages[p.ID] = true
+
+		for _, id := range p.QuestionIDs {
+			if q.Questions == nil || !q.Questions.Contains(id) {
+				return customerror.Wrap(
+					errorcatalog.Catalog.MustGet(errorcatalog.ErrQuestionnaireInvalidPage),
+					fmt.Errorf("page %s refers to the unknown question %s", p.ID, id),
+				)
+			}
+
+			if other, ok := paged[id]; ok {
+				return customerror.Wrap(
+					errorcatalog.Catalog.MustGet(errorcatalog.ErrQuestionnaireInvalidPage),
+					fmt.Errorf("question %s is part of pages %s, and %s", id, other, p.ID),
+				)
+			}
+
+			paged[id] = p.ID
+		}
+	}
+
+	return nil
+}
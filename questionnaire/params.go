@@ -0,0 +1,79 @@
+// NOTE: Called `params` for consistency (see option/params.go comment).
+
+package questionnaire
+
+//////
+// Vars, consts, and types.
+//////
+
+// Func allows to set options.
+type Func func(o *Options) error
+
+// Options contains the settings of a questionnaire.
+type Options struct {
+	// Outcomes the session is classified as, once finished.
+	Outcomes []Outcome `json:"outcomes"`
+
+	// Pages group questions answered together.
+	Pages []Page `json:"pages"`
+
+	// Quiz settings of assessments.
+	Quiz *Quiz `json:"quiz"`
+
+	// Validate the questionnaire graph, refusing to build invalid ones.
+	Validate bool `json:"validate"`
+}
+
+// WithValidation validates the questionnaire graph (see `Analyze`), refusing
+// to build invalid ones.
+func WithValidation(validate bool) Func {
+	return func(o *Options) error {
+		o.Validate = validate
+
+		return nil
+	}
+}
+
+// WithOutcome adds outcomes the session is classified as, once finished. See
+// `Outcome`.
+func WithOutcome(outcomes ...Outcome) Func {
+	return func(o *Options) error {
+		for _, out := range outcomes {
+			if err := out.Validate(); err != nil {
+				return err
+			}
+		}
+
+		o.Outcomes = append(o.Outcomes, outcomes...)
+
+		return nil
+	}
+}
+
+// WithPage adds pages grouping questions answered together. See `Page`.
+func WithPage(pages ...Page) Func {
+	return func(o *Options) error {
+		for _, p := range pages {
+			if err := p.Validate(); err != nil {
+				return err
+			}
+		}
+
+		o.Pages = append(o.Pages, pages...)
+
+		return nil
+	}
+}
+
+// WithQuiz turns the questionnaire into an assessment. See `Quiz`.
+func WithQuiz(q Quiz) Func {
+	return func(o *Options) error {
+		if err := q.Validate(); err != nil {
+			return err
+		}
+
+		o.Quiz = &q
+
+		return nil
+	}
+}
@@ -22,9 +22,19 @@ type Questionnaire struct {
 	// Hash is a hash based on SHA-256. The goal is to avoid data tampering.
 	Hash string `json:"hash" bson:"hash"`
 
+	// Outcomes the session is classified as, once finished, if any.
+	Outcomes []Outcome `json:"outcomes,omitempty" bson:"outcomes,omitempty"`
+
+	// Pages group questions answered together, if any. Questions not part of
+	// a page are answered one at a time.
+	Pages []Page `json:"pages,omitempty" bson:"pages,omitempty"`
+
 	// Questions is a list of questions.
 	Questions *safeorderedmap.SafeOrderedMap[question.Question] `json:"questions" bson:"questions" validate:"required,dive,required"`
 
+	// Quiz settings, if it's an assessment.
+	Quiz *Quiz `json:"quiz,omitempty" bson:"quiz,omitempty"`
+
 	// Title of the questionnaire.
 	Title string `json:"title" bson:"title" validate:"required"`
 }
@@ -44,12 +54,49 @@ func (q *Questionnaire) generateHash() (string, error) {
 	return hashString, nil
 }
 
+// Clone returns a copy of the questionnaire which doesn't share its questions,
+// nor their options - machines keep changing them, e.g.: previous question
+// IDs.
+func (q Questionnaire) Clone() Questionnaire {
+	if q.Questions == nil {
+		return q
+	}
+
+	questions := safeorderedmap.New[question.Question]()
+
+	q.Questions.Each(func(id string, qst question.Question) {
+		if qst.Options != nil {
+			qst.Options = qst.Options.Clone()
+		}
+
+		questions.Add(id, qst)
+	})
+
+	q.Questions = questions
+
+	return q
+}
+
 //////
 // Factory.
 //////
 
 // New creates a new questionnaire.
 func New(title string, questions ...question.Question) (*Questionnaire, error) {
+	return NewWithParams(title, questions)
+}
+
+// NewWithParams creates a new questionnaire with params, e.g.: `WithValidation`.
+func NewWithParams(title string, questions []question.Question, params ...Func) (*Questionnaire, error) {
+	o := &Options{}
+
+	// Applies params.
+	for _, param := range params {
+		if err := param(o); err != nil {
+			return nil, err
+		}
+	}
+
 	questionMap := safeorderedmap.New[question.Question]()
 
 	for i, q := range questions {
@@ -60,6 +107,9 @@ func New(title string, questions ...question.Question) (*Questionnaire, error) {
 	}
 
 	q := &Questionnaire{
+		Outcomes:  o.Outcomes,
+		Pages:     o.Pages,
+		Quiz:      o.Quiz,
 		Title:     title,
 		Questions: questionMap,
 	}
@@ -68,6 +118,20 @@ func New(title string, questions ...question.Question) (*Questionnaire, error) {
 		return nil, err
 	}
 
+	if err := q.validatePages(); err != nil {
+		return nil, err
+	}
+
+	if err := q.validateOutcomes(); err != nil {
+		return nil, err
+	}
+
+	if o.Validate {
+		if err := q.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	h, err := q.generateHash()
 	if err != nil {
 		return nil, err
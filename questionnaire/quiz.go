@@ -0,0 +1,36 @@
+package questionnaire
+
+import (
+	"github.com/thalesfsp/customerror"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// Quiz turns the questionnaire into an assessment, e.g.: certification
+// quizzes. Options flagged correct are the answer key - see
+// `option.WithCorrect`. Once completed, the machine finishes as succeeded if
+// the score passes, otherwise as failed.
+type Quiz struct {
+	// Pass is the minimum normalized score - from 0 to 100, to pass.
+	Pass float64 `json:"pass" bson:"pass"`
+}
+
+//////
+// Methods.
+//////
+
+// Passed returns true if the normalized `score` passes.
+func (q Quiz) Passed(score float64) bool {
+	return score >= q.Pass
+}
+
+// Validate the quiz.
+func (q Quiz) Validate() error {
+	if q.Pass < 0 || q.Pass > 100 {
+		return customerror.NewInvalidError("quiz pass, it must be from 0 to 100")
+	}
+
+	return nil
+}
@@ -0,0 +1,3 @@
+// Package rule provides conditional branching rules evaluated against the
+// answers given so far.
+package rule
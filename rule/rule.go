@@ -0,0 +1,354 @@
+package rule
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thalesfsp/customerror"
+	"github.com/thalesfsp/status"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// Operator is the comparison operator of a condition.
+type Operator string
+
+// Operators. Only `Answered`, and `Skipped` match regardless of the value.
+// The others - negations (`NotEqual`, `NotIn`) included, don't match
+// unanswered, nor skipped questions: "ne X" isn't the negation of "eq X"
+// there. Route them with `Answered`, `Skipped`, or a rule without conditions.
+const (
+	Answered           Operator = "answered"
+	Contains           Operator = "contains"
+	Equal              Operator = "eq"
+	GreaterThan        Operator = "gt"
+	GreaterThanOrEqual Operator = "gte"
+	In                 Operator = "in"
+	LessThan           Operator = "lt"
+	LessThanOrEqual    Operator = "lte"
+	NotEqual           Operator = "ne"
+	NotIn              Operator = "nin"
+	Skipped            Operator = "skipped"
+)
+
+// Lookup returns the value of the answer to the question `questionID`. `ok`
+// is false if the question wasn't answered. Skipped questions are answered
+// without value (nil).
+type Lookup func(questionID string) (value any, ok bool)
+
+// Condition compares the answer to a question against a value.
+type Condition struct {
+	// QuestionID is the ID of the question which answer is compared.
+	QuestionID string `json:"questionID" bson:"questionID" validate:"required"`
+
+	// Operator is the comparison operator.
+	Operator Operator `json:"operator" bson:"operator" validate:"required"`
+
+	// Value is the value to compare against. Not used by `Answered`, and
+	// `Skipped`.
+	Value any `json:"value,omitempty" bson:"value,omitempty"`
+}
+
+// Rule routes to the next question, and/or sets the state when all of its
+// conditions are met. A rule without conditions always matches, which is
+// useful as the last - "else" - rule.
+type Rule struct {
+	// Conditions to be met (AND).
+	Conditions []Condition `json:"conditions" bson:"conditions"`
+
+	// NextQuestionID is the next question ID.
+	NextQuestionID string `json:"nextQuestionID" bson:"nextQuestionID"`
+
+	// State is the state to be set.
+	State status.Status `json:"state" bson:"state"`
+}
+
+//////
+// Methods.
+//////
+
+// String implements the Stringer interface.
+func (o Operator) String() string {
+	return string(o)
+}
+
+// Match returns true if the answers satisfy the condition.
+//
+//nolint:cyclop
+func (c Condition) Match(lookup Lookup) bool {
+	value, ok := lookup(c.QuestionID)
+
+	switch c.Operator {
+	case Answered:
+		return ok && value != nil
+	case Skipped:
+		return ok && value == nil
+	}
+
+	if !ok || value == nil {
+		return false
+	}
+
+	switch c.Operator {
+	case Equal:
+		return equal(value, c.Value)
+	case NotEqual:
+		return !equal(value, c.Value)
+	case LessThan:
+		r, ok := compare(value, c.Value)
+
+		return ok && r < 0
+	case LessThanOrEqual:
+		r, ok := compare(value, c.Value)
+
+		return ok && r <= 0
+	case GreaterThan:
+		r, ok := compare(value, c.Value)
+
+		return ok && r > 0
+	case GreaterThanOrEqual:
+		r, ok := compare(value, c.Value)
+
+		return ok && r >= 0
+	case In:
+		return contains(c.Value, value)
+	case NotIn:
+		return !contains(c.Value, value)
+	case Contains:
+		if s, ok := value.(string); ok {
+			if sub, ok := c.Value.(string); ok {
+				return strings.Contains(s, sub)
+			}
+		}
+
+		return contains(value, c.Value)
+	}
+
+	return false
+}
+
+// Validate the condition.
+func (c Condition) Validate() error {
+	if c.QuestionID == "" {
+		return customerror.NewRequiredError("condition question ID")
+	}
+
+	switch c.Operator {
+	case Answered, Contains, Equal, GreaterThan, GreaterThanOrEqual, In,
+		LessThan, LessThanOrEqual, NotEqual, NotIn, Skipped:
+		return nil
+	}
+
+	return customerror.NewInvalidError("condition operator " + c.Operator.String())
+}
+
+// Match returns true if all conditions are met.
+func (r Rule) Match(lookup Lookup) bool {
+	for _, c := range r.Conditions {
+		if !c.Match(lookup) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Validate the rule.
+func (r Rule) Validate() error {
+	if r.NextQuestionID == "" && (r.State == "" || r.State == status.None) {
+		return customerror.NewMissingError("rule next question ID or state")
+	}
+
+	for _, c := range r.Conditions {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//////
+// Exported functionalities.
+//////
+
+// Evaluate returns the first rule which matches, in order.
+func Evaluate(lookup Lookup, rules ...Rule) (Rule, bool) {
+	for _, r := range rules {
+		if r.Match(lookup) {
+			return r, true
+		}
+	}
+
+	return Rule{}, false
+}
+
+//////
+// Factory.
+//////
+
+// If creates a new condition.
+func If(questionID string, operator Operator, value any) Condition {
+	return Condition{
+		QuestionID: questionID,
+		Operator:   operator,
+		Value:      value,
+	}
+}
+
+// Between creates the conditions of a numeric range: the answer to the
+// question `questionID` is greater than, or equal to `low`, and less than
+// `high`, e.g.: `rule.GoTo("minor", rule.Between("age", 0, 18)...)`.
+func Between(questionID string, low, high any) []Condition {
+	return []Condition{
+		If(questionID, GreaterThanOrEqual, low),
+		If(questionID, LessThan, high),
+	}
+}
+
+// GoTo creates a rule which routes to the question `id` when all conditions
+// are met.
+func GoTo(id string, conditions ...Condition) Rule {
+	return Rule{
+		Conditions:     conditions,
+		NextQuestionID: id,
+		State:          status.None,
+	}
+}
+
+// SetState creates a rule which sets the state `s` when all conditions are
+// met.
+func SetState(s status.Status, conditions ...Condition) Rule {
+	return Rule{
+		Conditions: conditions,
+		State:      s,
+	}
+}
+
+//////
+// Helpers.
+//////
+
+// normalize converts numbers to float64, and slices to []any. It allows to
+// compare values regardless of being created programmatically, or loaded
+// from storage (e.g. JSON).
+func normalize(v any) any {
+	rv := reflect.ValueOf(v)
+
+	//nolint:exhaustive
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint())
+	case reflect.Float32:
+		// Avoids float32 precision artifacts, e.g.: 1.1 -> 1.100000023841858.
+		f, err := strconv.ParseFloat(strconv.FormatFloat(rv.Float(), 'g', -1, 32), 64)
+		if err != nil {
+			return rv.Float()
+		}
+
+		return f
+	case reflect.Float64:
+		return rv.Float()
+	case reflect.Slice, reflect.Array:
+		s := make([]any, rv.Len())
+
+		for i := 0; i < rv.Len(); i++ {
+			s[i] = normalize(rv.Index(i).Interface())
+		}
+
+		return s
+	}
+
+	return v
+}
+
+// equal compares two values after normalization. Times are equal if they
+// represent the same instant.
+func equal(a, b any) bool {
+	if _, ok := a.(time.Time); ok {
+		r, ok := compare(a, b)
+
+		return ok && r == 0
+	}
+
+	return reflect.DeepEqual(normalize(a), normalize(b))
+}
+
+// compare returns -1, 0, or 1 comparing numbers, strings, or times. Times are
+// compared against times, or RFC 3339 dates, and date-times. `ok` is false if
+// values aren't comparable.
+func compare(a, b any) (int, bool) {
+	switch x := normalize(a).(type) {
+	case time.Time:
+		y, ok := toTime(b, x.Location())
+		if !ok {
+			return 0, false
+		}
+
+		return x.Compare(y), true
+	case float64:
+		y, ok := normalize(b).(float64)
+		if !ok {
+			return 0, false
+		}
+
+		switch {
+		case x < y:
+			return -1, true
+		case x > y:
+			return 1, true
+		}
+
+		return 0, true
+	case string:
+		y, ok := b.(string)
+		if !ok {
+			return 0, false
+		}
+
+		return strings.Compare(x, y), true
+	}
+
+	return 0, false
+}
+
+// toTime converts `v` to time. Strings are parsed as RFC 3339 dates - in the
+// location `loc`, or date-times.
+func toTime(v any, loc *time.Location) (time.Time, bool) {
+	switch t := v.(type) {
+	case time.Time:
+		return t, true
+	case string:
+		if parsed, err := time.ParseInLocation("2006-01-02", t, loc); err == nil {
+			return parsed, true
+		}
+
+		if parsed, err := time.Parse(time.RFC3339Nano, t); err == nil {
+			return parsed, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// contains returns true if `list` (slice) contains `v`.
+func contains(list, v any) bool {
+	s, ok := normalize(list).([]any)
+	if !ok {
+		return false
+	}
+
+	for _, item := range s {
+		if equal(item, v) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,118 @@
+package rule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thalesfsp/status"
+)
+
+func TestEvaluate(t *testing.T) {
+	answers := map[string]any{
+		"age":       17,
+		"birth":     time.Date(2007, 3, 1, 0, 0, 0, 0, time.UTC),
+		"country":   "BR",
+		"height":    float32(1.1),
+		"languages": []string{"go", "rust"},
+		"nickname":  nil,
+	}
+
+	lookup := func(questionID string) (any, bool) {
+		v, ok := answers[questionID]
+
+		return v, ok
+	}
+
+	tests := []struct {
+		name   string
+		rules  []Rule
+		want   string
+		wantOk bool
+	}{
+		{
+			name:   "Should match less than",
+			rules:  []Rule{GoTo("guardian", If("age", LessThan, 18)), GoTo("adult")},
+			want:   "guardian",
+			wantOk: true,
+		},
+		{
+			name:   "Should match between",
+			rules:  []Rule{GoTo("adult", Between("age", 18, 200)...), GoTo("minor", Between("age", 0, 18)...)},
+			want:   "minor",
+			wantOk: true,
+		},
+		{
+			name:   "Should match dates",
+			rules:  []Rule{GoTo("minor", If("birth", GreaterThan, "2006-01-01"), If("birth", Equal, "2007-03-01T00:00:00Z"))},
+			want:   "minor",
+			wantOk: true,
+		},
+		{
+			name:   "Should match values loaded from storage",
+			rules:  []Rule{GoTo("guardian", If("age", Equal, float64(17)), If("height", Equal, 1.1))},
+			want:   "guardian",
+			wantOk: true,
+		},
+		{
+			name:   "Should fallback to the rule without conditions",
+			rules:  []Rule{GoTo("guardian", If("age", GreaterThanOrEqual, 18)), GoTo("adult")},
+			want:   "adult",
+			wantOk: true,
+		},
+		{
+			name:   "Should require all conditions",
+			rules:  []Rule{GoTo("cpf", If("country", Equal, "BR"), If("age", GreaterThan, 18))},
+			wantOk: false,
+		},
+		{
+			name:   "Should match in",
+			rules:  []Rule{GoTo("cpf", If("country", In, []string{"BR", "PT"}))},
+			want:   "cpf",
+			wantOk: true,
+		},
+		{
+			name:   "Should match contains",
+			rules:  []Rule{GoTo("gopher", If("languages", Contains, "go"))},
+			want:   "gopher",
+			wantOk: true,
+		},
+		{
+			name:   "Should match skipped",
+			rules:  []Rule{GoTo("nickname", If("nickname", Skipped, nil))},
+			want:   "nickname",
+			wantOk: true,
+		},
+		{
+			name:   "Should not match unanswered",
+			rules:  []Rule{GoTo("email", If("email", NotEqual, "")), GoTo("email", If("email", Answered, nil))},
+			wantOk: false,
+		},
+		{
+			name:   "Should not match negations of unanswered, nor skipped",
+			rules:  []Rule{GoTo("email", If("email", NotIn, []string{"a"})), GoTo("nickname", If("nickname", NotEqual, "Gopher"))},
+			wantOk: false,
+		},
+		{
+			name:   "Should fallback unanswered negations to the rule without conditions",
+			rules:  []Rule{GoTo("contact", If("email", NotEqual, "yes")), GoTo("fallback")},
+			want:   "fallback",
+			wantOk: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Evaluate(lookup, tt.rules...)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got.NextQuestionID)
+		})
+	}
+}
+
+func TestRule_Validate(t *testing.T) {
+	assert.NoError(t, GoTo("1", If("2", Equal, 1)).Validate())
+	assert.NoError(t, SetState(status.Completed).Validate())
+	assert.Error(t, GoTo("").Validate())
+	assert.Error(t, GoTo("1", If("", Equal, 1)).Validate())
+	assert.Error(t, GoTo("1", If("2", "like", 1)).Validate())
+}
@@ -0,0 +1,10 @@
+// Package schema publishes the JSON Schemas (draft-07) of the documents the
+// library emits, and stores: questionnaires, events, and answers - typed
+// options included. Consumers in other languages can validate, or generate
+// code from them. Documents are checked against them with `Validate`.
+//
+// Schemas are generated from the Go types, run `make schema` after changing
+// them.
+package schema
+
+//go:generate go run ./internal/gen
@@ -0,0 +1,330 @@
+package schema
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/thalesfsp/questionnaire/answer"
+	"github.com/thalesfsp/questionnaire/event"
+	"github.com/thalesfsp/questionnaire/option"
+	"github.com/thalesfsp/questionnaire/question"
+	"github.com/thalesfsp/questionnaire/questionnaire"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// optionRef is the reference to the definition of typed options.
+const optionRef = "#/definitions/option.Option"
+
+// object is a JSON Schema (sub)document.
+type object = map[string]any
+
+var (
+	marshalerType     = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	optionType        = reflect.TypeOf(option.Option[any]{})
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	timeType          = reflect.TypeOf(time.Time{})
+)
+
+// optionFields are the fields holding options of any type, e.g.: a question's
+// options, or an answer's chosen ones, indexed by their struct type.
+var optionFields = map[reflect.Type]map[string]bool{
+	reflect.TypeOf(answer.Answer{}):     {"Option": true, "Options": true, "Cells": true},
+	reflect.TypeOf(question.Question{}): {"Options": true},
+}
+
+// listFields are the ordered maps serialized as lists of their values, e.g.:
+// a question's options, indexed by their struct type.
+var listFields = map[reflect.Type]map[string]bool{
+	reflect.TypeOf(question.Question{}): {"Options": true},
+}
+
+// documented are the types with a custom serialization following their
+// fields - and `listFields`.
+var documented = map[reflect.Type]bool{
+	reflect.TypeOf(question.Question{}): true,
+}
+
+// roots are the Go types of the documents.
+var roots = map[Kind]reflect.Type{
+	Answer:        reflect.TypeOf(answer.Answer{}),
+	Event:         reflect.TypeOf(event.Event{}),
+	Questionnaire: reflect.TypeOf(questionnaire.Questionnaire{}),
+}
+
+// generator reflects Go types into JSON Schema definitions, following the
+// `encoding/json` rules.
+type generator struct {
+	definitions object
+	types       map[string]reflect.Type
+}
+
+//////
+// Exported functionalities.
+//////
+
+// Generate the JSON Schema (draft-07) of the document `k` from its Go type.
+// Published schemas are generated with it - see `go generate`.
+func Generate(k Kind) ([]byte, error) {
+	root, ok := roots[k]
+	if !ok {
+		return nil, invalidKind(k)
+	}
+
+	g := &generator{definitions: object{}, types: map[string]reflect.Type{}}
+
+	if err := g.option(); err != nil {
+		return nil, err
+	}
+
+	s, err := g.schema(root, "")
+	if err != nil {
+		return nil, err
+	}
+
+	s["$schema"] = "http://json-schema.org/draft-07/schema#"
+	s["$id"] = k.ID()
+	s["title"] = root.String()
+	s["definitions"] = g.definitions
+
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(b, '\n'), nil
+}
+
+//////
+// Helpers.
+//////
+
+// option defines typed options - any of the supported value types. Options
+// are persisted with their next question ID (see option/document.go).
+func (g *generator) option() error {
+	s, err := g.fields(optionType)
+	if err != nil {
+		return err
+	}
+
+	properties := s["properties"].(object)
+
+	properties["nextQuestionID"] = object{"type": "string"}
+	properties["value"] = object{
+		"type":  []string{"array", "boolean", "number", "string"},
+		"items": object{"type": []string{"boolean", "number", "string"}},
+	}
+
+	s["required"] = append(s["required"].([]string), "nextQuestionID")
+	s["title"] = "option.Option"
+
+	g.definitions["option.Option"] = s
+
+	return nil
+}
+
+// schema returns the schema of the type `t`. Values of interface types are
+// described by `anyRef`, if set, otherwise they're anything.
+//
+//nolint:cyclop
+func (g *generator) schema(t reflect.Type, anyRef string) (object, error) {
+	if t.Kind() == reflect.Pointer {
+		return g.schema(t.Elem(), anyRef)
+	}
+
+	switch {
+	case t == timeType:
+		return object{"type": "string", "format": "date-time"}, nil
+	case t.Kind() == reflect.Struct && strings.HasPrefix(t.Name(), "SafeOrderedMap["):
+		// Serialized as an object, indexed by key.
+		values, err := g.values(t, anyRef)
+		if err != nil {
+			return nil, err
+		}
+
+		return object{"type": "object", "additionalProperties": values}, nil
+	case t.Kind() == reflect.Struct && strings.HasPrefix(t.Name(), "Option["):
+		return object{"$ref": optionRef}, nil
+	case documented[t]:
+		return g.ref(t)
+	case t.Implements(marshalerType), reflect.PointerTo(t).Implements(marshalerType),
+		t.Implements(textMarshalerType), reflect.PointerTo(t).Implements(textMarshalerType):
+		return nil, fmt.Errorf("%s has a custom serialization", t)
+	}
+
+	//nolint:exhaustive
+	switch t.Kind() {
+	case reflect.Bool:
+		return object{"type": "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return object{"type": "integer"}, nil
+	case reflect.Float32, reflect.Float64:
+		return object{"type": "number"}, nil
+	case reflect.String:
+		return object{"type": "string"}, nil
+	case reflect.Interface:
+		if anyRef != "" {
+			return object{"$ref": anyRef}, nil
+		}
+
+		return object{}, nil
+	case reflect.Slice, reflect.Array:
+		items, err := g.schema(t.Elem(), anyRef)
+		if err != nil {
+			return nil, err
+		}
+
+		return object{"type": "array", "items": items}, nil
+	case reflect.Map:
+		values, err := g.schema(t.Elem(), anyRef)
+		if err != nil {
+			return nil, err
+		}
+
+		return object{"type": "object", "additionalProperties": values}, nil
+	case reflect.Struct:
+		return g.ref(t)
+	}
+
+	return nil, fmt.Errorf("%s isn't supported", t)
+}
+
+// list returns the schema of the ordered map `t` serialized as a list of its
+// values.
+func (g *generator) list(t reflect.Type, anyRef string) (object, error) {
+	items, err := g.values(t, anyRef)
+	if err != nil {
+		return nil, err
+	}
+
+	return object{"type": "array", "items": items}, nil
+}
+
+// values returns the schema of the values of the ordered map `t`.
+func (g *generator) values(t reflect.Type, anyRef string) (object, error) {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	get, _ := reflect.PointerTo(t).MethodByName("Get")
+
+	return g.schema(get.Type.Out(0), anyRef)
+}
+
+// ref defines the struct `t` - once, returning a reference to it.
+func (g *generator) ref(t reflect.Type) (object, error) {
+	name := path.Base(t.PkgPath()) + "." + t.Name()
+
+	if other, ok := g.types[name]; ok {
+		if other != t {
+			return nil, fmt.Errorf("%s, and %s are both named %s", t, other, name)
+		}
+
+		return object{"$ref": "#/definitions/" + name}, nil
+	}
+
+	// Registered before its fields, allowing recursion.
+	g.types[name] = t
+
+	s, err := g.fields(t)
+	if err != nil {
+		return nil, err
+	}
+
+	s["title"] = name
+
+	g.definitions[name] = s
+
+	return object{"$ref": "#/definitions/" + name}, nil
+}
+
+// fields returns the schema of the struct `t`, with its fields - embedded
+// ones flattened. Fields always serialized are required, and nullable unless
+// `omitempty` is set.
+func (g *generator) fields(t reflect.Type) (object, error) {
+	properties := object{}
+	required := []string{}
+
+	if err := g.collect(t, properties, &required); err != nil {
+		return nil, err
+	}
+
+	return object{
+		"type":                 "object",
+		"properties":           properties,
+		"required":             required,
+		"additionalProperties": false,
+	}, nil
+}
+
+// collect adds the fields of the struct `t` to `properties`, and `required`.
+func (g *generator) collect(t reflect.Type, properties object, required *[]string) error {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		omitEmpty := strings.Contains(","+opts+",", ",omitempty,")
+
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			if err := g.collect(f.Type, properties, required); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if !f.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+
+		anyRef := ""
+
+		if optionFields[t][f.Name] {
+			anyRef = optionRef
+		}
+
+		describe := g.schema
+
+		if listFields[t][f.Name] {
+			describe = g.list
+		}
+
+		s, err := describe(f.Type, anyRef)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %w", t, f.Name, err)
+		}
+
+		//nolint:exhaustive
+		switch f.Type.Kind() {
+		case reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice:
+			if !omitEmpty {
+				s = object{"anyOf": []object{s, {"type": "null"}}}
+			}
+		}
+
+		properties[name] = s
+
+		if !omitEmpty || f.Type.Kind() == reflect.Struct {
+			*required = append(*required, name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+// Command gen writes the JSON Schemas of the documents to the current
+// directory. See `schema.Generate`.
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/thalesfsp/questionnaire/schema"
+)
+
+func main() {
+	for _, k := range schema.Kinds {
+		b, err := schema.Generate(k)
+		if err != nil {
+			log.Fatalln(err)
+		}
+
+		//nolint:gosec
+		if err := os.WriteFile(k.File(), b, 0o644); err != nil {
+			log.Fatalln(err)
+		}
+	}
+}
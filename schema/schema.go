@@ -0,0 +1,127 @@
+package schema
+
+import (
+	"bytes"
+	"embed"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema"
+	"github.com/thalesfsp/customerror"
+	"github.com/thalesfsp/questionnaire/errorcatalog"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// Kind of document.
+type Kind string
+
+const (
+	Answer        Kind = "answer"
+	Event         Kind = "event"
+	Questionnaire Kind = "questionnaire"
+)
+
+// BaseURL is where the schemas are published.
+const BaseURL = "https://github.com/thalesfsp/questionnaire/schema/"
+
+// Kinds are all kinds of documents.
+var Kinds = []Kind{Answer, Event, Questionnaire}
+
+// published are the generated schemas.
+//
+//go:embed *.schema.json
+var published embed.FS
+
+// compiled schemas, indexed by kind.
+var (
+	compiled   = map[Kind]*jsonschema.Schema{}
+	compiledMu sync.Mutex
+)
+
+//////
+// Methods.
+//////
+
+// String implements the Stringer interface.
+func (k Kind) String() string {
+	return string(k)
+}
+
+// File returns the name of the schema file of the kind, e.g.:
+// "event.schema.json".
+func (k Kind) File() string {
+	return string(k) + ".schema.json"
+}
+
+// ID returns the URL identifying the schema of the kind.
+func (k Kind) ID() string {
+	return BaseURL + k.File()
+}
+
+//////
+// Exported functionalities.
+//////
+
+// Schema returns the published JSON Schema of the document `k`.
+func Schema(k Kind) ([]byte, error) {
+	if _, ok := roots[k]; !ok {
+		return nil, invalidKind(k)
+	}
+
+	return published.ReadFile(k.File())
+}
+
+// Validate checks the JSON document `doc` against the schema of the kind `k`.
+func Validate(k Kind, doc []byte) error {
+	s, err := compile(k)
+	if err != nil {
+		return err
+	}
+
+	if err := s.Validate(bytes.NewReader(doc)); err != nil {
+		return customerror.Wrap(errorcatalog.Catalog.MustGet(errorcatalog.ErrSchemaMismatch), err)
+	}
+
+	return nil
+}
+
+//////
+// Helpers.
+//////
+
+// compile the published schema of the kind `k`, once.
+func compile(k Kind) (*jsonschema.Schema, error) {
+	compiledMu.Lock()
+	defer compiledMu.Unlock()
+
+	if s, ok := compiled[k]; ok {
+		return s, nil
+	}
+
+	b, err := Schema(k)
+	if err != nil {
+		return nil, err
+	}
+
+	c := jsonschema.NewCompiler()
+
+	if err := c.AddResource(k.ID(), bytes.NewReader(b)); err != nil {
+		return nil, err
+	}
+
+	s, err := c.Compile(k.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	compiled[k] = s
+
+	return s, nil
+}
+
+// invalidKind returns the error of an unknown kind of document.
+func invalidKind(k Kind) error {
+	return customerror.NewInvalidError("schema kind " + k.String())
+}
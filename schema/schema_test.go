@@ -0,0 +1,175 @@
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thalesfsp/questionnaire/answer"
+	"github.com/thalesfsp/questionnaire/errorcatalog"
+	"github.com/thalesfsp/questionnaire/fsm"
+	"github.com/thalesfsp/questionnaire/option"
+	"github.com/thalesfsp/questionnaire/question"
+	"github.com/thalesfsp/questionnaire/questionnaire"
+	"github.com/thalesfsp/questionnaire/types"
+	"github.com/thalesfsp/status"
+)
+
+func TestGenerate(t *testing.T) {
+	for _, k := range Kinds {
+		t.Run("Should work - "+k.String(), func(t *testing.T) {
+			got, err := Generate(k)
+			assert.NoError(t, err)
+
+			// Published schemas must be up to date, run `make schema`.
+			want, err := Schema(k)
+			assert.NoError(t, err)
+			assert.JSONEq(t, string(want), string(got))
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	ctx := context.Background()
+
+	q1 := question.MustNew[bool]("adult", "Are you an adult?", types.Logical,
+		question.WithOption(
+			option.MustNew(true, option.WithID("yes"), option.WithNextQuestionID("languages")),
+			option.MustNew(false, option.WithID("no"), option.WithState(status.Failed)),
+		),
+	)
+
+	q2 := question.MustNew[[]string]("languages", "Languages", types.MultipleSelect,
+		question.WithOption(
+			option.MustNew([]string{"go"}, option.WithID("go"), option.WithCorrect(true)),
+			option.MustNew([]string{"rust"}, option.WithID("rust")),
+		),
+		question.WithNextQuestionID("age"),
+	)
+
+	q3 := question.MustNew[string]("age", "Age", types.Integer,
+		question.WithNumber(question.Number{HasMin: true, Min: 18}),
+		question.WithState(status.Completed),
+	)
+
+	q, err := questionnaire.NewWithParams(
+		"Schema",
+		[]question.Question{q1, q2, q3},
+		questionnaire.WithOutcome(questionnaire.Outcome{ID: "all", Label: "All"}),
+	)
+	assert.NoError(t, err)
+
+	machine, err := fsm.New(ctx, "12345", *q, nil)
+	assert.NoError(t, err)
+
+	assert.NoError(t, machine.Start())
+
+	yes, err := question.GetOption[bool](q1, "yes")
+	assert.NoError(t, err)
+
+	assert.NoError(t, fsm.Forward(ctx, machine, yes))
+	assert.NoError(t, fsm.ForwardMany(ctx, machine, "go", "rust"))
+	assert.NoError(t, fsm.ForwardNumber(ctx, machine, 42))
+
+	journal := machine.GetJournal()
+	last := journal[len(journal)-1]
+
+	assert.NotNil(t, last.Outcome)
+
+	languages, ok := last.Answers.Get("languages")
+	assert.True(t, ok)
+
+	tests := []struct {
+		name    string
+		kind    Kind
+		doc     any
+		tamper  func(m map[string]any)
+		wantErr error
+	}{
+		{
+			name: "Should work - questionnaire",
+			kind: Questionnaire,
+			doc:  q,
+		},
+		{
+			name: "Should work - event",
+			kind: Event,
+			doc:  last,
+		},
+		{
+			name: "Should work - first event",
+			kind: Event,
+			doc:  journal[0],
+		},
+		{
+			name: "Should work - answer",
+			kind: Answer,
+			doc:  languages,
+		},
+		{
+			name: "Should work - empty answer",
+			kind: Answer,
+			doc:  answer.Answer{},
+		},
+		{
+			name:    "Should fail - field changed shape",
+			kind:    Event,
+			doc:     last,
+			tamper:  func(m map[string]any) { m["state"] = 1 },
+			wantErr: errorcatalog.Catalog.MustGet(errorcatalog.ErrSchemaMismatch),
+		},
+		{
+			name:    "Should fail - unknown field",
+			kind:    Answer,
+			doc:     languages,
+			tamper:  func(m map[string]any) { m["comment"] = "Unknown" },
+			wantErr: errorcatalog.Catalog.MustGet(errorcatalog.ErrSchemaMismatch),
+		},
+		{
+			name: "Should fail - untyped option value",
+			kind: Answer,
+			doc:  languages,
+			tamper: func(m map[string]any) {
+				m["options"].([]any)[0].(map[string]any)["value"] = map[string]any{"go": true}
+			},
+			wantErr: errorcatalog.Catalog.MustGet(errorcatalog.ErrSchemaMismatch),
+		},
+		{
+			name:    "Should fail - missing field",
+			kind:    Questionnaire,
+			doc:     q,
+			tamper:  func(m map[string]any) { delete(m, "title") },
+			wantErr: errorcatalog.Catalog.MustGet(errorcatalog.ErrSchemaMismatch),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.doc)
+			assert.NoError(t, err)
+
+			if tt.tamper != nil {
+				var m map[string]any
+
+				assert.NoError(t, json.Unmarshal(b, &m))
+
+				tt.tamper(m)
+
+				b, err = json.Marshal(m)
+				assert.NoError(t, err)
+			}
+
+			err = Validate(tt.kind, b)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+
+	assert.Error(t, Validate("unknown", []byte("{}")))
+}
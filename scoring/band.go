@@ -0,0 +1,75 @@
+package scoring
+
+import (
+	"github.com/thalesfsp/questionnaire/answer"
+	"github.com/thalesfsp/questionnaire/question"
+	"github.com/thalesfsp/questionnaire/questionnaire"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// Band interprets a range of totals, e.g.: severity.
+type Band struct {
+	// Label of the band, e.g.: "moderate".
+	Label string `json:"label" bson:"label"`
+
+	// Max is the highest total of the band, inclusive.
+	Max float64 `json:"max" bson:"max"`
+
+	// Min is the lowest total of the band, inclusive.
+	Min float64 `json:"min" bson:"min"`
+}
+
+// PHQ9 is the PHQ-9 (Patient Health Questionnaire) depression severity. Nine
+// items, scored from 0 to 3 - see `Frequency`.
+var PHQ9 = Bands("phq-9", Sum,
+	Band{Label: "minimal", Min: 0, Max: 4},
+	Band{Label: "mild", Min: 5, Max: 9},
+	Band{Label: "moderate", Min: 10, Max: 14},
+	Band{Label: "moderately severe", Min: 15, Max: 19},
+	Band{Label: "severe", Min: 20, Max: 27},
+)
+
+// GAD7 is the GAD-7 (Generalized Anxiety Disorder) anxiety severity. Seven
+// items, scored from 0 to 3 - see `Frequency`.
+var GAD7 = Bands("gad-7", Sum,
+	Band{Label: "minimal", Min: 0, Max: 4},
+	Band{Label: "mild", Min: 5, Max: 9},
+	Band{Label: "moderate", Min: 10, Max: 14},
+	Band{Label: "severe", Min: 15, Max: 21},
+)
+
+//////
+// Exported functionalities.
+//////
+
+// Bands scores with the strategy `s`, interpreting its total by the first
+// matching band. The score is named `name`.
+func Bands(name string, s Strategy, bands ...Band) Strategy {
+	return func(q questionnaire.Questionnaire, answers []answer.Answer) Score {
+		score := s(q, answers)
+		score.Strategy = name
+
+		for _, b := range bands {
+			if score.Total >= b.Min && score.Total <= b.Max {
+				score.Band = b.Label
+
+				break
+			}
+		}
+
+		return score
+	}
+}
+
+// Frequency is the response scale of the PHQ-9, and GAD-7 items - "Over the
+// last 2 weeks, how often have you been bothered by...", scored from 0 to 3.
+func Frequency() question.Scale {
+	return question.Scale{
+		Labels: []string{"Not at all", "Several days", "More than half the days", "Nearly every day"},
+		Max:    3,
+		Min:    0,
+	}
+}
@@ -0,0 +1,4 @@
+// Package scoring computes the score of a session from the weights of its
+// questions, and options, e.g.: sum, weighted average, per-page subtotals, and
+// clinical scales such as PHQ-9, and GAD-7.
+package scoring
@@ -0,0 +1,119 @@
+package scoring
+
+import (
+	"github.com/thalesfsp/questionnaire/answer"
+	"github.com/thalesfsp/questionnaire/option"
+	"github.com/thalesfsp/questionnaire/question"
+	"github.com/thalesfsp/questionnaire/questionnaire"
+	"github.com/thalesfsp/questionnaire/types"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// Quiz bands.
+const (
+	Failed = "failed"
+	Passed = "passed"
+)
+
+//////
+// Exported functionalities.
+//////
+
+// Graded returns true if the question `qst` is graded: one of its options, at
+// least, is correct. Matrices aren't graded.
+func Graded(qst question.Question) bool {
+	if !Scored(qst) || qst.Meta.Matrix != nil {
+		return false
+	}
+
+	for _, raw := range qst.Options.Values() {
+		if correct(raw) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Credit returns the credit of the answer `aswr`, from 0 to 1. Multiple-select
+// answers award partial credit: the correct selected options, minus the
+// incorrect ones, over the correct options - never negative. `ok` is false if
+// the answer is skipped, or its question isn't graded.
+func Credit(aswr answer.Answer) (float64, bool) {
+	if aswr.IsSkipped() || !Graded(aswr.Question) {
+		return 0, false
+	}
+
+	var right, wrong float64
+
+	for _, raw := range aswr.Selected() {
+		if correct(raw) {
+			right++
+		} else {
+			wrong++
+		}
+	}
+
+	if aswr.Question.Type != types.MultipleSelect {
+		return right, true
+	}
+
+	var total float64
+
+	for _, raw := range aswr.Question.Options.Values() {
+		if correct(raw) {
+			total++
+		}
+	}
+
+	credit := (right - wrong) / total
+
+	if credit < 0 {
+		credit = 0
+	}
+
+	return credit, true
+}
+
+// Quiz scores by the credit of the answers, weighted by the question's weight.
+// Its total ranges from zero to the sum of the graded questions' weights.
+// Questionnaires with quiz settings are interpreted as passed, or failed - see
+// `questionnaire.Quiz`.
+func Quiz(q questionnaire.Questionnaire, answers []answer.Answer) Score {
+	s := tally("quiz", q, answers, measure{
+		bounds: func(question.Question) (float64, float64) {
+			return 0, 1
+		},
+		points: Credit,
+		scored: Graded,
+	})
+
+	if q.Quiz != nil {
+		s.Band = Failed
+
+		if q.Quiz.Passed(s.Normalized) {
+			s.Band = Passed
+		}
+	}
+
+	return s
+}
+
+//////
+// Helpers.
+//////
+
+// correct returns true if the option `raw` is correct, including options
+// loaded from storage (e.g. JSON).
+func correct(raw any) bool {
+	if o, ok := raw.(interface{ IsCorrect() bool }); ok {
+		return o.IsCorrect()
+	}
+
+	opt, err := option.ToOption[any](raw)
+
+	return err == nil && opt.IsCorrect()
+}
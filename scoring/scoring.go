@@ -0,0 +1,291 @@
+package scoring
+
+import (
+	"github.com/thalesfsp/questionnaire/answer"
+	"github.com/thalesfsp/questionnaire/option"
+	"github.com/thalesfsp/questionnaire/question"
+	"github.com/thalesfsp/questionnaire/questionnaire"
+	"github.com/thalesfsp/questionnaire/types"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// Subtotal is the score of a set of questions. Points are weighted by the
+// question's weight.
+type Subtotal struct {
+	// Answered is the number of scored questions answered - skipped ones
+	// excluded.
+	Answered int `json:"answered" bson:"answered"`
+
+	// Max is the maximum achievable total.
+	Max float64 `json:"max" bson:"max"`
+
+	// Min is the minimum achievable total.
+	Min float64 `json:"min" bson:"min"`
+
+	// Normalized is the total in the [0, 100] range, from `Min` to `Max`.
+	Normalized float64 `json:"normalized" bson:"normalized"`
+
+	// Total is the sum of the points of the answers.
+	Total float64 `json:"total" bson:"total"`
+}
+
+// Score of a session. It's a running score: the achievable range considers
+// all scored questions, answered or not.
+type Score struct {
+	Subtotal `json:",inline" bson:",inline"`
+
+	// Average is the average of the points of the answers, weighted by the
+	// question's weight.
+	Average float64 `json:"average" bson:"average"`
+
+	// Band is the interpretation of the total, if any, e.g.: "moderate".
+	Band string `json:"band,omitempty" bson:"band,omitempty"`
+
+	// Sections are the subtotals of each page, indexed by page ID.
+	Sections map[string]Subtotal `json:"sections,omitempty" bson:"sections,omitempty"`
+
+	// Strategy is the name of the strategy which computed the score.
+	Strategy string `json:"strategy" bson:"strategy"`
+}
+
+// measure scores the questions, and answers of a strategy.
+type measure struct {
+	// bounds returns the minimum, and maximum points of a question.
+	bounds func(question.Question) (float64, float64)
+
+	// points returns the points of an answer, if scored.
+	points func(answer.Answer) (float64, bool)
+
+	// scored returns true if a question is scored.
+	scored func(question.Question) bool
+}
+
+// Strategy computes the score of the `answers` to the questionnaire `q`.
+type Strategy func(q questionnaire.Questionnaire, answers []answer.Answer) Score
+
+//////
+// Methods.
+//////
+
+// add the weighted points `p` of an answer, or - if not `answered`, only its
+// achievable range.
+func (s *Subtotal) add(weight, p, min, max float64, answered bool) {
+	s.Max += weight * max
+	s.Min += weight * min
+
+	if answered {
+		s.Answered++
+		s.Total += weight * p
+	}
+}
+
+// normalize sets the normalized total.
+func (s *Subtotal) normalize() {
+	s.Normalized = 0
+
+	if s.Max > s.Min {
+		s.Normalized = 100 * (s.Total - s.Min) / (s.Max - s.Min)
+	}
+}
+
+// Value returns the value of the score reference `ref`, e.g.:
+// `questionnaire.ScoreTotal`. `ok` is false if it isn't one, or references an
+// unscored page.
+func (s Score) Value(ref string) (any, bool) {
+	switch ref {
+	case questionnaire.ScoreAverage:
+		return s.Average, true
+	case questionnaire.ScoreBand:
+		return s.Band, true
+	case questionnaire.ScoreNormalized:
+		return s.Normalized, true
+	case questionnaire.ScoreTotal:
+		return s.Total, true
+	}
+
+	if pageID, ok := questionnaire.SectionOf(ref); ok {
+		if sub, ok := s.Sections[pageID]; ok {
+			return sub.Normalized, true
+		}
+	}
+
+	return nil, false
+}
+
+//////
+// Exported functionalities.
+//////
+
+// Scored returns true if the question `qst` is scored: it has options, and
+// isn't a ranking. Rankings, and respondent-provided values aren't scored, see
+// `question.Ranking.Points`.
+func Scored(qst question.Question) bool {
+	return qst.Options != nil &&
+		!qst.Options.Empty() &&
+		qst.Type != types.Ranking &&
+		!qst.Type.IsInput()
+}
+
+// Range returns the minimum, and maximum points of the question `qst` - not
+// weighted. Selection constraints aren't considered: multiple selections
+// range from all negative, to all positive weights.
+func Range(qst question.Question) (float64, float64) {
+	if !Scored(qst) {
+		return 0, 0
+	}
+
+	var lowest, highest, negative, positive float64
+
+	for i, raw := range qst.Options.Values() {
+		w, _ := weight(raw)
+
+		if i == 0 || w < lowest {
+			lowest = w
+		}
+
+		if i == 0 || w > highest {
+			highest = w
+		}
+
+		if w < 0 {
+			negative += w
+		} else {
+			positive += w
+		}
+	}
+
+	multiple := qst.Type == types.MultipleSelect || (qst.Meta.Matrix != nil && qst.Meta.Matrix.Multiple)
+
+	if multiple {
+		lowest, highest = negative, positive
+	}
+
+	if qst.Meta.Matrix != nil {
+		rows := float64(len(qst.Meta.Matrix.Rows))
+
+		return rows * lowest, rows * highest
+	}
+
+	return lowest, highest
+}
+
+// Points returns the points of the answer `aswr` - not weighted: the weight of
+// the chosen option, or the sum of the selected ones' - matrices included.
+// `ok` is false if the answer is skipped, or its question isn't scored.
+func Points(aswr answer.Answer) (float64, bool) {
+	if aswr.IsSkipped() || !Scored(aswr.Question) {
+		return 0, false
+	}
+
+	var p float64
+
+	for _, raw := range aswr.Selected() {
+		w, _ := weight(raw)
+
+		p += w
+	}
+
+	return p, true
+}
+
+// Sum scores by the sum of the points of the answers, weighted by the
+// question's weight. Pages are scored as sections. It's the default strategy.
+func Sum(q questionnaire.Questionnaire, answers []answer.Answer) Score {
+	return tally("sum", q, answers, measure{
+		bounds: Range,
+		points: Points,
+		scored: Scored,
+	})
+}
+
+//////
+// Helpers.
+//////
+
+// weight returns the weight of the option `raw`, including options loaded from
+// storage (e.g. JSON).
+func weight(raw any) (float64, bool) {
+	if o, ok := raw.(interface{ GetWeight() int }); ok {
+		return float64(o.GetWeight()), true
+	}
+
+	opt, err := option.ToOption[any](raw)
+	if err != nil {
+		return 0, false
+	}
+
+	return float64(opt.GetWeight()), true
+}
+
+// tally scores the `answers` to the questionnaire `q` by the measure `m`,
+// naming the score `name`.
+func tally(name string, q questionnaire.Questionnaire, answers []answer.Answer, m measure) Score {
+	s := Score{Strategy: name}
+
+	if q.Questions == nil {
+		return s
+	}
+
+	byID := make(map[string]answer.Answer, len(answers))
+
+	for _, aswr := range answers {
+		byID[aswr.GetID()] = aswr
+	}
+
+	sections := map[string]*Subtotal{}
+
+	var weights float64
+
+	for _, qst := range q.Questions.Values() {
+		if !m.scored(qst) {
+			continue
+		}
+
+		w := float64(qst.Meta.Weight)
+		min, max := m.bounds(qst)
+
+		var (
+			p        float64
+			answered bool
+		)
+
+		if aswr, ok := byID[qst.GetID()]; ok {
+			p, answered = m.points(aswr)
+		}
+
+		s.add(w, p, min, max, answered)
+
+		if answered {
+			weights += w
+		}
+
+		if page, ok := q.PageOf(qst.GetID()); ok {
+			if sections[page.ID] == nil {
+				sections[page.ID] = &Subtotal{}
+			}
+
+			sections[page.ID].add(w, p, min, max, answered)
+		}
+	}
+
+	s.normalize()
+
+	if weights != 0 {
+		s.Average = s.Total / weights
+	}
+
+	if len(sections) > 0 {
+		s.Sections = make(map[string]Subtotal, len(sections))
+
+		for id, sub := range sections {
+			sub.normalize()
+
+			s.Sections[id] = *sub
+		}
+	}
+
+	return s
+}
@@ -0,0 +1,246 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thalesfsp/questionnaire/answer"
+	"github.com/thalesfsp/questionnaire/internal/shared"
+	"github.com/thalesfsp/questionnaire/option"
+	"github.com/thalesfsp/questionnaire/question"
+	"github.com/thalesfsp/questionnaire/questionnaire"
+	"github.com/thalesfsp/questionnaire/types"
+	"github.com/thalesfsp/status"
+)
+
+func TestSum(t *testing.T) {
+	yes := option.MustNew(true, option.WithID("yes"), option.WithWeight(2), option.WithNextQuestionID("2"))
+	no := option.MustNew(false, option.WithID("no"), option.WithWeight(0), option.WithNextQuestionID("2"))
+
+	go1 := option.MustNew("go", option.WithWeight(3))
+	rust := option.MustNew("rust", option.WithWeight(2))
+	php := option.MustNew("php", option.WithWeight(-1))
+
+	q1 := question.MustNew[bool]("1", "Q1", types.Logical, question.WithOption(yes, no), question.WithWeight(2))
+	q2 := question.MustNew[string]("2", "Q2", types.MultipleSelect,
+		question.WithOption(go1, rust, php),
+		question.WithNextQuestionID("3"),
+	)
+	q3 := question.MustNew[string]("3", "Q3", types.Text, question.WithState(status.Completed))
+
+	q, err := questionnaire.NewWithParams(
+		"Scoring",
+		[]question.Question{q1, q2, q3},
+		questionnaire.WithPage(questionnaire.Page{ID: "p1", QuestionIDs: []string{"1"}}),
+	)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		answers func() []answer.Answer
+		want    Score
+	}{
+		{
+			name: "Should work",
+			answers: func() []answer.Answer {
+				a1, err := answer.New(q1, yes)
+				assert.NoError(t, err)
+
+				a2, err := answer.NewMany(q2, []any{go1, php})
+				assert.NoError(t, err)
+
+				a3, err := answer.NewText(q3, "Not scored")
+				assert.NoError(t, err)
+
+				return []answer.Answer{a1, a2, a3}
+			},
+			want: Score{
+				Subtotal: Subtotal{Answered: 2, Max: 9, Min: -1, Normalized: 70, Total: 6},
+				Average:  2,
+				Sections: map[string]Subtotal{
+					"p1": {Answered: 1, Max: 4, Min: 0, Normalized: 100, Total: 4},
+				},
+				Strategy: "sum",
+			},
+		},
+		{
+			name: "Should work - running, and skipped",
+			answers: func() []answer.Answer {
+				a1, err := answer.NewSkipped(q1)
+				assert.NoError(t, err)
+
+				return []answer.Answer{a1}
+			},
+			want: Score{
+				Subtotal: Subtotal{Answered: 0, Max: 9, Min: -1, Normalized: 10, Total: 0},
+				Sections: map[string]Subtotal{
+					"p1": {Answered: 0, Max: 4, Min: 0, Normalized: 0, Total: 0},
+				},
+				Strategy: "sum",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			answers := tt.answers()
+
+			assert.Equal(t, tt.want, Sum(*q, answers))
+
+			// Answers loaded from storage score the same.
+			b, err := shared.Marshal(answers)
+			assert.NoError(t, err)
+
+			var loaded []answer.Answer
+
+			assert.NoError(t, shared.Unmarshal(b, &loaded))
+			assert.Equal(t, tt.want, Sum(*q, loaded))
+		})
+	}
+}
+
+func TestBands(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy Strategy
+		items    int
+		points   []int
+		want     string
+	}{
+		{name: "Should work - PHQ-9 minimal", strategy: PHQ9, items: 9, points: []int{0, 1, 0, 0, 1, 0, 0, 1, 0}, want: "minimal"},
+		{name: "Should work - PHQ-9 moderately severe", strategy: PHQ9, items: 9, points: []int{2, 2, 2, 2, 2, 2, 2, 1, 1}, want: "moderately severe"},
+		{name: "Should work - GAD-7 severe", strategy: GAD7, items: 7, points: []int{3, 3, 3, 2, 2, 2, 0}, want: "severe"},
+		{name: "Should work - GAD-7 mild", strategy: GAD7, items: 7, points: []int{1, 1, 1, 1, 1, 0, 0}, want: "mild"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			qsts := make([]question.Question, 0, tt.items)
+
+			for i := 0; i < tt.items; i++ {
+				qsts = append(qsts, question.MustNewScale(
+					string(rune('a'+i)),
+					"Item",
+					types.Likert,
+					Frequency(),
+					question.WithState(status.Completed),
+				))
+			}
+
+			q, err := questionnaire.New("Clinical", qsts...)
+			assert.NoError(t, err)
+
+			answers := []answer.Answer{}
+			total := 0
+
+			for i, qst := range qsts {
+				opt, err := question.GetOption[int](qst, string(rune('0'+tt.points[i])))
+				assert.NoError(t, err)
+
+				aswr, err := answer.New(qst, opt)
+				assert.NoError(t, err)
+
+				answers = append(answers, aswr)
+				total += tt.points[i]
+			}
+
+			s := tt.strategy(*q, answers)
+
+			assert.Equal(t, tt.want, s.Band)
+			assert.Equal(t, float64(total), s.Total)
+			assert.Equal(t, float64(3*tt.items), s.Max)
+		})
+	}
+}
+
+func TestQuiz(t *testing.T) {
+	paris := option.MustNew("paris", option.WithCorrect(true), option.WithFeedback("Right!"))
+	rome := option.MustNew("rome", option.WithFeedback("Rome is Italy's capital."))
+
+	two := option.MustNew(2, option.WithCorrect(true))
+	three := option.MustNew(3, option.WithCorrect(true))
+	four := option.MustNew(4)
+	five := option.MustNew(5, option.WithCorrect(true))
+
+	q1 := question.MustNew[string]("capital", "Capital of France", types.SingleSelect,
+		question.WithOption(paris, rome),
+		question.WithNextQuestionID("primes"),
+	)
+	q2 := question.MustNew[int]("primes", "Primes", types.MultipleSelect,
+		question.WithOption(two, three, four, five),
+		question.WithNextQuestionID("comment"),
+		question.WithWeight(3),
+	)
+	q3 := question.MustNew[string]("comment", "Comment", types.Text, question.WithState(status.Completed))
+
+	q, err := questionnaire.NewWithParams(
+		"Quiz",
+		[]question.Question{q1, q2, q3},
+		questionnaire.WithQuiz(questionnaire.Quiz{Pass: 75}),
+	)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		capital option.Option[string]
+		primes  []any
+		want    Score
+	}{
+		{
+			name:    "Should work",
+			capital: paris,
+			primes:  []any{two, three, five},
+			want: Score{
+				Subtotal: Subtotal{Answered: 2, Max: 4, Min: 0, Normalized: 100, Total: 4},
+				Average:  1,
+				Band:     Passed,
+				Strategy: "quiz",
+			},
+		},
+		{
+			name:    "Should work - partial credit",
+			capital: paris,
+			primes:  []any{two, three, four},
+			want: Score{
+				Subtotal: Subtotal{Answered: 2, Max: 4, Min: 0, Normalized: 50, Total: 2},
+				Average:  0.5,
+				Band:     Failed,
+				Strategy: "quiz",
+			},
+		},
+		{
+			name:    "Should work - no negative credit",
+			capital: rome,
+			primes:  []any{two, four},
+			want: Score{
+				Subtotal: Subtotal{Answered: 2, Max: 4, Min: 0, Normalized: 0, Total: 0},
+				Band:     Failed,
+				Strategy: "quiz",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a1, err := answer.New(q1, tt.capital)
+			assert.NoError(t, err)
+
+			a2, err := answer.NewMany(q2, tt.primes)
+			assert.NoError(t, err)
+
+			a3, err := answer.NewText(q3, "Not graded")
+			assert.NoError(t, err)
+
+			answers := []answer.Answer{a1, a2, a3}
+
+			assert.Equal(t, tt.want, Quiz(*q, answers))
+			assert.Equal(t, []string{tt.capital.GetFeedback()}, a1.Feedback())
+
+			// Answers loaded from storage grade the same.
+			b, err := shared.Marshal(answers)
+			assert.NoError(t, err)
+
+			var loaded []answer.Answer
+
+			assert.NoError(t, shared.Unmarshal(b, &loaded))
+			assert.Equal(t, tt.want, Quiz(*q, loaded))
+		})
+	}
+}
@@ -0,0 +1,3 @@
+// Package store provides the persistence layer for the state machine: session
+// snapshots, and journals.
+package store
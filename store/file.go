@@ -0,0 +1,397 @@
+package store
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/thalesfsp/customerror"
+	"github.com/thalesfsp/questionnaire/errorcatalog"
+	"github.com/thalesfsp/questionnaire/event"
+	"github.com/thalesfsp/questionnaire/internal/shared"
+	"github.com/thalesfsp/questionnaire/questionnaire"
+	"github.com/thalesfsp/validation"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+const (
+	// journalExtension is the extension of the journal files (JSON lines).
+	journalExtension = ".jsonl"
+
+	// questionnairesDir is the directory of the questionnaires referenced by
+	// the journals.
+	questionnairesDir = "questionnaires"
+
+	// snapshotExtension is the extension of the snapshot files.
+	snapshotExtension = ".json"
+)
+
+// File is a file-system Store. Each questionnaire has its own directory, and
+// each user has a snapshot (JSON) and a journal (JSON lines) file in it.
+// Journals are compacted: events are stored as deltas, and questionnaires once
+// per hash (see `event.CompactJournal`):
+//
+//	<dir>/<questionnaireID>/<userID>.json
+//	<dir>/<questionnaireID>/<userID>.jsonl
+//	<dir>/<questionnaireID>/questionnaires/<hash>.json
+//
+// NOTE: Versions are checked, and writes serialized within the process only.
+type File struct {
+	sync.RWMutex `json:"-" bson:"-"`
+
+	// Dir is the root directory.
+	Dir string `json:"dir" bson:"dir" validate:"required"`
+}
+
+//////
+// Implements the Store interface.
+//////
+
+// Save the session snapshot.
+func (f *File) Save(ctx context.Context, e event.Event) error {
+	b, err := shared.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	p, err := f.path(e.UserID, e.QuestionnaireID, snapshotExtension)
+	if err != nil {
+		return err
+	}
+
+	if err := checkVersion(storedVersion(p), e); err != nil {
+		return err
+	}
+
+	if err := mkdir(p); err != nil {
+		return err
+	}
+
+	// Writes to a temporary file, then renames it - a crash never leaves a
+	// partially written snapshot behind.
+	tmp := p + ".tmp"
+
+	if err := os.WriteFile(tmp, b, 0o600); err != nil {
+		return customerror.NewFailedToError("save snapshot", customerror.WithError(err))
+	}
+
+	if err := os.Rename(tmp, p); err != nil {
+		return customerror.NewFailedToError("save snapshot", customerror.WithError(err))
+	}
+
+	return nil
+}
+
+// Load the session snapshot.
+func (f *File) Load(ctx context.Context, userID, questionnaireID string) (event.Event, error) {
+	f.RLock()
+	defer f.RUnlock()
+
+	p, err := f.path(userID, questionnaireID, snapshotExtension)
+	if err != nil {
+		return event.Event{}, err
+	}
+
+	b, err := os.ReadFile(p)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return event.Event{}, errorcatalog.Catalog.MustGet(errorcatalog.ErrStoreSessionNotFound)
+		}
+
+		return event.Event{}, customerror.NewFailedToError("load snapshot", customerror.WithError(err))
+	}
+
+	var e event.Event
+	if err := shared.Unmarshal(b, &e); err != nil {
+		return event.Event{}, err
+	}
+
+	return e, nil
+}
+
+// Append an event to the session journal.
+func (f *File) Append(ctx context.Context, e event.Event) error {
+	f.Lock()
+	defer f.Unlock()
+
+	p, err := f.path(e.UserID, e.QuestionnaireID, journalExtension)
+	if err != nil {
+		return err
+	}
+
+	if err := mkdir(p); err != nil {
+		return err
+	}
+
+	if err := f.saveQuestionnaire(e); err != nil {
+		return err
+	}
+
+	// The first event of the journal is the baseline.
+	info, err := os.Stat(p)
+	baseline := err != nil || info.Size() == 0
+
+	b, err := shared.Marshal(event.Compact(e, baseline))
+	if err != nil {
+		return err
+	}
+
+	// Add break line.
+	b = append(b, []byte("\n")...)
+
+	file, err := os.OpenFile(p, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return customerror.NewFailedToError("open journal", customerror.WithError(err))
+	}
+
+	defer file.Close()
+
+	if _, err := file.Write(b); err != nil {
+		return customerror.NewFailedToError("append to journal", customerror.WithError(err))
+	}
+
+	return nil
+}
+
+// Journal returns the session journal, in order.
+func (f *File) Journal(ctx context.Context, userID, questionnaireID string) ([]event.Event, error) {
+	f.RLock()
+	defer f.RUnlock()
+
+	p, err := f.path(userID, questionnaireID, journalExtension)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(p)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return []event.Event{}, nil
+		}
+
+		return nil, customerror.NewFailedToError("open journal", customerror.WithError(err))
+	}
+
+	defer file.Close()
+
+	j := event.CompactJournal{
+		Deltas:         []event.Delta{},
+		Questionnaires: map[string]questionnaire.Questionnaire{},
+	}
+
+	scanner := bufio.NewScanner(file)
+
+	// Baselines embed the answers, so lines can be long.
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var d event.Delta
+		if err := shared.Unmarshal(scanner.Bytes(), &d); err != nil {
+			return nil, err
+		}
+
+		if _, ok := j.Questionnaires[d.QuestionnaireHash]; !ok {
+			q, err := f.loadQuestionnaire(questionnaireID, d.QuestionnaireHash)
+			if err != nil {
+				return nil, err
+			}
+
+			j.Questionnaires[d.QuestionnaireHash] = q
+		}
+
+		j.Deltas = append(j.Deltas, d)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, customerror.NewFailedToError("read journal", customerror.WithError(err))
+	}
+
+	return j.Materialize()
+}
+
+// List sessions matching the filter.
+func (f *File) List(ctx context.Context, filter Filter) ([]Session, error) {
+	f.RLock()
+	defer f.RUnlock()
+
+	sessions := []Session{}
+
+	matches, err := filepath.Glob(filepath.Join(f.Dir, "*", "*"+snapshotExtension))
+	if err != nil {
+		return nil, customerror.NewFailedToError("list sessions", customerror.WithError(err))
+	}
+
+	for _, p := range matches {
+		questionnaireID, err := url.PathUnescape(filepath.Base(filepath.Dir(p)))
+		if err != nil {
+			continue
+		}
+
+		userID, err := url.PathUnescape(strings.TrimSuffix(filepath.Base(p), snapshotExtension))
+		if err != nil {
+			continue
+		}
+
+		if !filter.Match(userID, questionnaireID) {
+			continue
+		}
+
+		b, err := os.ReadFile(p)
+		if err != nil {
+			return nil, customerror.NewFailedToError("list sessions", customerror.WithError(err))
+		}
+
+		var e event.Event
+		if err := shared.Unmarshal(b, &e); err != nil {
+			return nil, err
+		}
+
+		sessions = append(sessions, newSession(e))
+	}
+
+	sortSessions(sessions)
+
+	return sessions, nil
+}
+
+//////
+// Helpers.
+//////
+
+// path returns the path of a session file. IDs are escaped, they can't escape
+// the root directory.
+func (f *File) path(userID, questionnaireID, ext string) (string, error) {
+	if userID == "" || questionnaireID == "" {
+		return "", customerror.NewRequiredError("user ID, and questionnaire ID")
+	}
+
+	if questionnaireID == "." || questionnaireID == ".." {
+		return "", customerror.NewInvalidError("questionnaire ID")
+	}
+
+	return filepath.Join(f.Dir, url.PathEscape(questionnaireID), url.PathEscape(userID)+ext), nil
+}
+
+// questionnairePath returns the path of the questionnaire `hash`, referenced by
+// the journals of the questionnaire `questionnaireID`.
+func (f *File) questionnairePath(questionnaireID, hash string) string {
+	return filepath.Join(
+		f.Dir,
+		url.PathEscape(questionnaireID),
+		questionnairesDir,
+		url.PathEscape(hash)+snapshotExtension,
+	)
+}
+
+// saveQuestionnaire stores the questionnaire of the event `e`, once per hash.
+func (f *File) saveQuestionnaire(e event.Event) error {
+	p := f.questionnairePath(e.QuestionnaireID, e.Questionnaire.Hash)
+
+	if _, err := os.Stat(p); err == nil {
+		return nil
+	}
+
+	b, err := shared.Marshal(e.Questionnaire)
+	if err != nil {
+		return err
+	}
+
+	if err := mkdir(p); err != nil {
+		return err
+	}
+
+	// Same as snapshots - a crash never leaves a partially written
+	// questionnaire behind.
+	tmp := p + ".tmp"
+
+	if err := os.WriteFile(tmp, b, 0o600); err != nil {
+		return customerror.NewFailedToError("save questionnaire", customerror.WithError(err))
+	}
+
+	if err := os.Rename(tmp, p); err != nil {
+		return customerror.NewFailedToError("save questionnaire", customerror.WithError(err))
+	}
+
+	return nil
+}
+
+// loadQuestionnaire loads the questionnaire `hash`, referenced by the journals
+// of the questionnaire `questionnaireID`.
+func (f *File) loadQuestionnaire(questionnaireID, hash string) (questionnaire.Questionnaire, error) {
+	b, err := os.ReadFile(f.questionnairePath(questionnaireID, hash))
+	if err != nil {
+		return questionnaire.Questionnaire{}, customerror.NewFailedToError("load questionnaire", customerror.WithError(err))
+	}
+
+	var q questionnaire.Questionnaire
+	if err := shared.Unmarshal(b, &q); err != nil {
+		return questionnaire.Questionnaire{}, err
+	}
+
+	return q, nil
+}
+
+// storedVersion returns the version of the snapshot file `p`, or zero if
+// there's none.
+func storedVersion(p string) int64 {
+	b, err := os.ReadFile(p)
+	if err != nil {
+		return 0
+	}
+
+	var v struct {
+		Version int64 `json:"version"`
+	}
+
+	if err := shared.Unmarshal(b, &v); err != nil {
+		return 0
+	}
+
+	return v.Version
+}
+
+// mkdir creates the directory of the session file `p`.
+func mkdir(p string) error {
+	if err := os.MkdirAll(filepath.Dir(p), 0o700); err != nil {
+		return customerror.NewFailedToError("create directory", customerror.WithError(err))
+	}
+
+	return nil
+}
+
+//////
+// Factory.
+//////
+
+// NewFile creates a new file-system Store rooted at `dir`.
+func NewFile(dir string) (*File, error) {
+	f := &File{
+		Dir: dir,
+	}
+
+	if err := validation.Validate(f); err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, customerror.NewFailedToError("create directory", customerror.WithError(err))
+	}
+
+	return f, nil
+}
@@ -0,0 +1,202 @@
+package store
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/thalesfsp/questionnaire/errorcatalog"
+	"github.com/thalesfsp/questionnaire/event"
+	"github.com/thalesfsp/questionnaire/internal/shared"
+	"github.com/thalesfsp/questionnaire/questionnaire"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// key identifies a session.
+type key struct {
+	questionnaireID string
+	userID          string
+}
+
+// revision identifies a version of a questionnaire.
+type revision struct {
+	questionnaireID string
+	hash            string
+}
+
+// Memory is an in-memory Store. Events are stored serialized, so they're
+// immutable snapshots - the machine keeps changing its answers. Journals are
+// compacted: questionnaires are stored once, and events as deltas (see
+// `event.CompactJournal`). Good for testing, and development.
+type Memory struct {
+	sync.RWMutex
+
+	journals       map[key][][]byte
+	questionnaires map[revision][]byte
+	snapshots      map[key][]byte
+	versions       map[key]int64
+}
+
+//////
+// Implements the Store interface.
+//////
+
+// Save the session snapshot.
+func (m *Memory) Save(ctx context.Context, e event.Event) error {
+	b, err := shared.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	k := key{e.QuestionnaireID, e.UserID}
+
+	if err := checkVersion(m.versions[k], e); err != nil {
+		return err
+	}
+
+	m.snapshots[k] = b
+	m.versions[k] = e.Version
+
+	return nil
+}
+
+// Load the session snapshot.
+func (m *Memory) Load(ctx context.Context, userID, questionnaireID string) (event.Event, error) {
+	m.RLock()
+	b, ok := m.snapshots[key{questionnaireID, userID}]
+	m.RUnlock()
+
+	if !ok {
+		return event.Event{}, errorcatalog.Catalog.MustGet(errorcatalog.ErrStoreSessionNotFound)
+	}
+
+	var e event.Event
+	if err := shared.Unmarshal(b, &e); err != nil {
+		return event.Event{}, err
+	}
+
+	return e, nil
+}
+
+// Append an event to the session journal.
+func (m *Memory) Append(ctx context.Context, e event.Event) error {
+	m.Lock()
+	defer m.Unlock()
+
+	k := key{e.QuestionnaireID, e.UserID}
+	r := revision{e.QuestionnaireID, e.Questionnaire.Hash}
+
+	if _, ok := m.questionnaires[r]; !ok {
+		b, err := shared.Marshal(e.Questionnaire)
+		if err != nil {
+			return err
+		}
+
+		m.questionnaires[r] = b
+	}
+
+	b, err := shared.Marshal(event.Compact(e, len(m.journals[k]) == 0))
+	if err != nil {
+		return err
+	}
+
+	m.journals[k] = append(m.journals[k], b)
+
+	return nil
+}
+
+// Journal returns the session journal, in order.
+func (m *Memory) Journal(ctx context.Context, userID, questionnaireID string) ([]event.Event, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	entries := m.journals[key{questionnaireID, userID}]
+
+	j := event.CompactJournal{
+		Deltas:         make([]event.Delta, 0, len(entries)),
+		Questionnaires: map[string]questionnaire.Questionnaire{},
+	}
+
+	for _, b := range entries {
+		var d event.Delta
+		if err := shared.Unmarshal(b, &d); err != nil {
+			return nil, err
+		}
+
+		if _, ok := j.Questionnaires[d.QuestionnaireHash]; !ok {
+			if qb, ok := m.questionnaires[revision{questionnaireID, d.QuestionnaireHash}]; ok {
+				var q questionnaire.Questionnaire
+				if err := shared.Unmarshal(qb, &q); err != nil {
+					return nil, err
+				}
+
+				j.Questionnaires[d.QuestionnaireHash] = q
+			}
+		}
+
+		j.Deltas = append(j.Deltas, d)
+	}
+
+	return j.Materialize()
+}
+
+// List sessions matching the filter.
+func (m *Memory) List(ctx context.Context, filter Filter) ([]Session, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	sessions := []Session{}
+
+	for k, b := range m.snapshots {
+		if !filter.Match(k.userID, k.questionnaireID) {
+			continue
+		}
+
+		var e event.Event
+		if err := shared.Unmarshal(b, &e); err != nil {
+			return nil, err
+		}
+
+		sessions = append(sessions, newSession(e))
+	}
+
+	sortSessions(sessions)
+
+	return sessions, nil
+}
+
+//////
+// Helpers.
+//////
+
+// sortSessions sorts sessions by questionnaire, and user IDs - consistent
+// results.
+func sortSessions(sessions []Session) {
+	sort.Slice(sessions, func(i, j int) bool {
+		if sessions[i].QuestionnaireID != sessions[j].QuestionnaireID {
+			return sessions[i].QuestionnaireID < sessions[j].QuestionnaireID
+		}
+
+		return sessions[i].UserID < sessions[j].UserID
+	})
+}
+
+//////
+// Factory.
+//////
+
+// NewMemory creates a new in-memory Store.
+func NewMemory() *Memory {
+	return &Memory{
+		journals:       map[key][][]byte{},
+		questionnaires: map[revision][]byte{},
+		snapshots:      map[key][]byte{},
+		versions:       map[key]int64{},
+	}
+}
@@ -0,0 +1,105 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thalesfsp/customerror"
+	"github.com/thalesfsp/questionnaire/errorcatalog"
+	"github.com/thalesfsp/questionnaire/event"
+	"github.com/thalesfsp/status"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// Filter to list sessions. Empty fields match everything.
+type Filter struct {
+	// QuestionnaireID is the ID of the questionnaire.
+	QuestionnaireID string `json:"questionnaireID" bson:"questionnaireID"`
+
+	// UserID is the ID of the user.
+	UserID string `json:"userID" bson:"userID"`
+}
+
+// Session is a summary of a user answering a questionnaire.
+type Session struct {
+	// QuestionnaireID is the ID of the questionnaire.
+	QuestionnaireID string `json:"questionnaireID" bson:"questionnaireID"`
+
+	// State is the latest state of the machine.
+	State status.Status `json:"state" bson:"state"`
+
+	// UpdatedAt is the time of the latest snapshot.
+	UpdatedAt time.Time `json:"updatedAt" bson:"updatedAt"`
+
+	// UserID is the ID of the user.
+	UserID string `json:"userID" bson:"userID"`
+}
+
+// Store persists the state machine. A session is identified by the user, and
+// the questionnaire IDs.
+type Store interface {
+	// Save the session snapshot - the latest state of the machine. Stale
+	// snapshots - not newer than the stored one - are rejected with
+	// `errorcatalog.ErrStoreVersionConflict`.
+	Save(ctx context.Context, e event.Event) error
+
+	// Load the session snapshot.
+	Load(ctx context.Context, userID, questionnaireID string) (event.Event, error)
+
+	// Append an event to the session journal.
+	Append(ctx context.Context, e event.Event) error
+
+	// Journal returns the session journal, in order.
+	Journal(ctx context.Context, userID, questionnaireID string) ([]event.Event, error)
+
+	// List sessions matching the filter.
+	List(ctx context.Context, filter Filter) ([]Session, error)
+}
+
+//////
+// Methods.
+//////
+
+// Match returns true if the session matches the filter.
+func (f Filter) Match(userID, questionnaireID string) bool {
+	if f.UserID != "" && f.UserID != userID {
+		return false
+	}
+
+	if f.QuestionnaireID != "" && f.QuestionnaireID != questionnaireID {
+		return false
+	}
+
+	return true
+}
+
+//////
+// Helpers.
+//////
+
+// checkVersion rejects stale writes: the snapshot (`e`) must be newer than the
+// stored one (`stored`). Unversioned snapshots aren't checked.
+func checkVersion(stored int64, e event.Event) error {
+	if e.Version == 0 || e.Version > stored {
+		return nil
+	}
+
+	return customerror.Wrap(
+		errorcatalog.Catalog.MustGet(errorcatalog.ErrStoreVersionConflict),
+		fmt.Errorf("version %d, stored %d", e.Version, stored),
+	)
+}
+
+// newSession creates a session summary from a snapshot.
+func newSession(e event.Event) Session {
+	return Session{
+		QuestionnaireID: e.QuestionnaireID,
+		State:           e.State,
+		UpdatedAt:       e.CreatedAt,
+		UserID:          e.UserID,
+	}
+}
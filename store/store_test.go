@@ -0,0 +1,136 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thalesfsp/params/common"
+	"github.com/thalesfsp/questionnaire/errorcatalog"
+	"github.com/thalesfsp/questionnaire/event"
+	"github.com/thalesfsp/questionnaire/questionnaire"
+	"github.com/thalesfsp/status"
+)
+
+func TestStore(t *testing.T) {
+	file, err := NewFile(t.TempDir())
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		store Store
+	}{
+		{
+			name:  "Should work - memory",
+			store: NewMemory(),
+		},
+		{
+			name:  "Should work - file",
+			store: file,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			_, err := tt.store.Load(ctx, "u1", "q1")
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrStoreSessionNotFound))
+
+			q := questionnaire.Questionnaire{Hash: "h1", Title: "Survey"}
+
+			events := []event.Event{
+				{Common: common.Common{ID: "e1"}, Questionnaire: q, QuestionnaireID: "q1", UserID: "u1", State: status.Runnning},
+				{Common: common.Common{ID: "e2"}, Questionnaire: q, QuestionnaireID: "q1", UserID: "u1", State: status.Completed},
+				{Common: common.Common{ID: "e3"}, QuestionnaireID: "q1", UserID: "u/2", State: status.Runnning},
+				{Common: common.Common{ID: "e4"}, QuestionnaireID: "q2", UserID: "u1", State: status.Runnning},
+			}
+
+			for _, e := range events {
+				assert.NoError(t, tt.store.Append(ctx, e))
+				assert.NoError(t, tt.store.Save(ctx, e))
+			}
+
+			// Snapshot is the latest event.
+			e, err := tt.store.Load(ctx, "u1", "q1")
+			assert.NoError(t, err)
+			assert.Equal(t, "e2", e.ID)
+			assert.Equal(t, status.Completed, e.State)
+
+			// Journal is in order.
+			journal, err := tt.store.Journal(ctx, "u1", "q1")
+			assert.NoError(t, err)
+			assert.Len(t, journal, 2)
+			assert.Equal(t, "e1", journal[0].ID)
+			assert.Equal(t, "e2", journal[1].ID)
+			assert.Equal(t, status.Completed, journal[1].State)
+			assert.Equal(t, "Survey", journal[1].Questionnaire.Title)
+
+			journal, err = tt.store.Journal(ctx, "u3", "q1")
+			assert.NoError(t, err)
+			assert.Empty(t, journal)
+
+			// List by questionnaire.
+			sessions, err := tt.store.List(ctx, Filter{QuestionnaireID: "q1"})
+			assert.NoError(t, err)
+			assert.Len(t, sessions, 2)
+			assert.Equal(t, "u/2", sessions[0].UserID)
+			assert.Equal(t, "u1", sessions[1].UserID)
+			assert.Equal(t, status.Completed, sessions[1].State)
+
+			// List by user.
+			sessions, err = tt.store.List(ctx, Filter{UserID: "u1"})
+			assert.NoError(t, err)
+			assert.Len(t, sessions, 2)
+			assert.Equal(t, "q1", sessions[0].QuestionnaireID)
+			assert.Equal(t, "q2", sessions[1].QuestionnaireID)
+
+			// List all.
+			sessions, err = tt.store.List(ctx, Filter{})
+			assert.NoError(t, err)
+			assert.Len(t, sessions, 3)
+		})
+	}
+}
+
+func TestStore_Save_version(t *testing.T) {
+	file, err := NewFile(t.TempDir())
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		store Store
+	}{
+		{
+			name:  "Should work - memory",
+			store: NewMemory(),
+		},
+		{
+			name:  "Should work - file",
+			store: file,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			conflict := errorcatalog.Catalog.MustGet(errorcatalog.ErrStoreVersionConflict)
+
+			assert.NoError(t, tt.store.Save(ctx, event.Event{QuestionnaireID: "q1", UserID: "u1", Version: 1}))
+			assert.NoError(t, tt.store.Save(ctx, event.Event{QuestionnaireID: "q1", UserID: "u1", Version: 2}))
+
+			// Stale writes are rejected.
+			assert.ErrorIs(t, tt.store.Save(ctx, event.Event{QuestionnaireID: "q1", UserID: "u1", Version: 2}), conflict)
+			assert.ErrorIs(t, tt.store.Save(ctx, event.Event{QuestionnaireID: "q1", UserID: "u1", Version: 1}), conflict)
+
+			e, err := tt.store.Load(ctx, "u1", "q1")
+			assert.NoError(t, err)
+			assert.Equal(t, int64(2), e.Version)
+
+			// Sessions are versioned independently.
+			assert.NoError(t, tt.store.Save(ctx, event.Event{QuestionnaireID: "q1", UserID: "u2", Version: 1}))
+
+			// Unversioned snapshots aren't checked.
+			assert.NoError(t, tt.store.Save(ctx, event.Event{QuestionnaireID: "q1", UserID: "u1"}))
+		})
+	}
+}
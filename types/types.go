@@ -8,8 +8,19 @@ package types
 type Type string
 
 const (
+	Attachment     Type = "attachment"
+	Date           Type = "date"
+	DateRange      Type = "date-range"
+	DateTime       Type = "date-time"
+	Decimal        Type = "decimal"
+	Integer        Type = "integer"
+	Likert         Type = "likert"
 	Logical        Type = "logical"
+	Matrix         Type = "matrix"
 	MultipleSelect Type = "multiple-select"
+	NPS            Type = "nps"
+	Ranking        Type = "ranking"
+	Rating         Type = "rating"
 	SingleSelect   Type = "single-select"
 	Text           Type = "text"
 )
@@ -22,3 +33,25 @@ const (
 func (t Type) String() string {
 	return string(t)
 }
+
+// IsInput returns true if questions of the type can be answered with a
+// respondent-provided value, instead of choosing options.
+func (t Type) IsInput() bool {
+	switch t {
+	case Attachment, Date, DateRange, DateTime, Decimal, Integer, Text:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsScale returns true if questions of the type are answered choosing a point
+// of an ordinal scale, e.g.: Likert, rating, and NPS.
+func (t Type) IsScale() bool {
+	switch t {
+	case Likert, NPS, Rating:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,168 @@
+package validator
+
+import (
+	"errors"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// Built-in validators.
+const (
+	CPF   = "cpf"
+	Email = "email"
+	Phone = "phone"
+	Regex = "regex"
+	SSN   = "ssn"
+	URL   = "url"
+)
+
+var (
+	// e164Regex matches E.164 phone numbers, e.g.: +5511999999999.
+	e164Regex = regexp.MustCompile(`^\+[1-9]\d{1,14}$`)
+
+	// ssnRegex matches US Social Security Numbers, e.g.: 123-45-6789.
+	ssnRegex = regexp.MustCompile(`^(\d{3})-?(\d{2})-?(\d{4})$`)
+
+	// nonDigitRegex matches formatting characters, e.g.: "." and "-".
+	nonDigitRegex = regexp.MustCompile(`\D`)
+)
+
+// builtins are the built-in validators, indexed by name.
+var builtins = map[string]Func{
+	CPF:   cpf,
+	Email: email,
+	Phone: phone,
+	Regex: pattern,
+	SSN:   ssn,
+	URL:   link,
+}
+
+//////
+// Helpers.
+//////
+
+// text returns the value as text.
+func text(value any) (string, error) {
+	s, ok := value.(string)
+	if !ok {
+		return "", errors.New("must be text")
+	}
+
+	return s, nil
+}
+
+// cpf validates Brazilian individual taxpayer numbers - including the check
+// digits. Formatting is ignored, e.g.: 123.456.789-09.
+func cpf(value any, _ string) error {
+	s, err := text(value)
+	if err != nil {
+		return err
+	}
+
+	digits := nonDigitRegex.ReplaceAllString(s, "")
+
+	if len(digits) != 11 || strings.Count(digits, digits[:1]) == 11 {
+		return errors.New("invalid CPF")
+	}
+
+	for _, n := range []int{9, 10} {
+		sum := 0
+
+		for i := 0; i < n; i++ {
+			sum += int(digits[i]-'0') * (n + 1 - i)
+		}
+
+		check := (sum * 10) % 11 % 10
+
+		if check != int(digits[n]-'0') {
+			return errors.New("invalid CPF")
+		}
+	}
+
+	return nil
+}
+
+// email validates email addresses, without display names.
+func email(value any, _ string) error {
+	s, err := text(value)
+	if err != nil {
+		return err
+	}
+
+	a, err := mail.ParseAddress(s)
+	if err != nil || a.Address != s {
+		return errors.New("invalid email")
+	}
+
+	return nil
+}
+
+// link validates absolute HTTP(S) URLs.
+func link(value any, _ string) error {
+	s, err := text(value)
+	if err != nil {
+		return err
+	}
+
+	u, err := url.ParseRequestURI(s)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("invalid URL")
+	}
+
+	return nil
+}
+
+// pattern validates the value matches the regular expression `arg`.
+func pattern(value any, arg string) error {
+	s, err := text(value)
+	if err != nil {
+		return err
+	}
+
+	re, err := regexp.Compile(arg)
+	if err != nil {
+		return errors.New("invalid pattern")
+	}
+
+	if !re.MatchString(s) {
+		return errors.New("doesn't match the pattern")
+	}
+
+	return nil
+}
+
+// phone validates E.164 phone numbers.
+func phone(value any, _ string) error {
+	s, err := text(value)
+	if err != nil {
+		return err
+	}
+
+	if !e164Regex.MatchString(s) {
+		return errors.New("invalid phone, it must be E.164, e.g.: +5511999999999")
+	}
+
+	return nil
+}
+
+// ssn validates US Social Security Numbers. Area 000, 666, and 900-999, group
+// 00, and serial 0000 are never assigned.
+func ssn(value any, _ string) error {
+	s, err := text(value)
+	if err != nil {
+		return err
+	}
+
+	m := ssnRegex.FindStringSubmatch(s)
+	if m == nil || m[1] == "000" || m[1] == "666" || m[1][0] == '9' || m[2] == "00" || m[3] == "0000" {
+		return errors.New("invalid SSN")
+	}
+
+	return nil
+}
@@ -0,0 +1,4 @@
+// Package validator provides a registry of named validators for answers, e.g.:
+// email, phone, and URL. Questions reference them by name, so they survive
+// serialization.
+package validator
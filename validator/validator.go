@@ -0,0 +1,206 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/thalesfsp/customerror"
+	"github.com/thalesfsp/questionnaire/errorcatalog"
+)
+
+//////
+// Consts, vars, and types.
+//////
+
+// Func validates the value of an answer. `arg` is the argument of the
+// reference, e.g.: a pattern. The message of the returned error is reported.
+type Func func(value any, arg string) error
+
+// Ref references a validator by name. It's how validators are set on
+// questions, and serialized.
+type Ref struct {
+	// Arg is the argument of the validator, e.g.: a pattern.
+	Arg string `json:"arg,omitempty" bson:"arg,omitempty"`
+
+	// Message replaces the validator's message, e.g.: translations.
+	Message string `json:"message,omitempty" bson:"message,omitempty"`
+
+	// Name of the validator.
+	Name string `json:"name" bson:"name"`
+}
+
+// FieldError is a validation failure of an answer.
+type FieldError struct {
+	// Message describes the failure.
+	Message string `json:"message" bson:"message"`
+
+	// QuestionID is the ID of the question answered.
+	QuestionID string `json:"questionID" bson:"questionID"`
+
+	// Validator is the name of the validator.
+	Validator string `json:"validator" bson:"validator"`
+}
+
+// Errors are the validation failures of an answer. It's an
+// `errorcatalog.ErrAnswerValidation` error.
+type Errors []FieldError
+
+// Registry of named validators. It's safe for concurrent use.
+type Registry struct {
+	mu sync.RWMutex
+
+	// funcs indexed by name.
+	funcs map[string]Func
+}
+
+// Default is the registry used by answers. It has the built-in validators.
+var Default = NewRegistry()
+
+//////
+// Methods.
+//////
+
+// Error implements the error interface.
+func (e FieldError) Error() string {
+	return fmt.Sprintf("question %s, validator %s: %s", e.QuestionID, e.Validator, e.Message)
+}
+
+// Error implements the error interface.
+func (e Errors) Error() string {
+	msgs := make([]string, 0, len(e))
+
+	for _, fe := range e {
+		msgs = append(msgs, fe.Error())
+	}
+
+	return fmt.Sprintf(
+		"%s. Wrapped Error(s): %s",
+		errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerValidation).Error(),
+		strings.Join(msgs, ". "),
+	)
+}
+
+// Unwrap allows `errors.Is` against `errorcatalog.ErrAnswerValidation`.
+func (e Errors) Unwrap() error {
+	return errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerValidation)
+}
+
+// Register the validator `f` as `name`. Names are unique.
+func (r *Registry) Register(name string, f Func) error {
+	if name == "" || f == nil {
+		return customerror.NewRequiredError("validator name, and func")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.funcs[name]; ok {
+		return customerror.NewInvalidError("validator " + name + ", already registered")
+	}
+
+	r.funcs[name] = f
+
+	return nil
+}
+
+// Get the validator `name`.
+func (r *Registry) Get(name string) (Func, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	f, ok := r.funcs[name]
+
+	return f, ok
+}
+
+// Validate the references: validators must be registered.
+func (r *Registry) Validate(refs ...Ref) error {
+	for _, ref := range refs {
+		if _, ok := r.Get(ref.Name); !ok {
+			return customerror.NewNotFoundError("validator " + ref.Name)
+		}
+	}
+
+	return nil
+}
+
+// Run the referenced validators against the `value` of the answer to the
+// question `questionID`. All failures are reported at once (`Errors`). Nil
+// values - e.g.: skipped questions, aren't validated.
+func (r *Registry) Run(questionID string, value any, refs ...Ref) error {
+	if value == nil {
+		return nil
+	}
+
+	var errs Errors
+
+	for _, ref := range refs {
+		f, ok := r.Get(ref.Name)
+		if !ok {
+			errs = append(errs, FieldError{
+				Message:    "validator not found",
+				QuestionID: questionID,
+				Validator:  ref.Name,
+			})
+
+			continue
+		}
+
+		if err := f(value, ref.Arg); err != nil {
+			msg := err.Error()
+
+			if ref.Message != "" {
+				msg = ref.Message
+			}
+
+			errs = append(errs, FieldError{
+				Message:    msg,
+				QuestionID: questionID,
+				Validator:  ref.Name,
+			})
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+//////
+// Exported functionalities.
+//////
+
+// Register the validator `f` as `name` in the default registry.
+func Register(name string, f Func) error {
+	return Default.Register(name, f)
+}
+
+// Run the referenced validators of the default registry.
+func Run(questionID string, value any, refs ...Ref) error {
+	return Default.Run(questionID, value, refs...)
+}
+
+//////
+// Factory.
+//////
+
+// NewRegistry creates a new Registry with the built-in validators.
+func NewRegistry() *Registry {
+	r := &Registry{
+		funcs: make(map[string]Func, len(builtins)),
+	}
+
+	for name, f := range builtins {
+		r.funcs[name] = f
+	}
+
+	return r
+}
+
+// Use creates a reference to the validator `name`, with the optional `arg`.
+func Use(name string, arg ...string) Ref {
+	return Ref{Arg: strings.Join(arg, ""), Name: name}
+}
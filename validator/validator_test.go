@@ -0,0 +1,97 @@
+package validator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thalesfsp/questionnaire/errorcatalog"
+)
+
+func TestRegistry_Run(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   any
+		ref     Ref
+		wantErr bool
+	}{
+		{name: "Should work - email", value: "john@example.com", ref: Use(Email)},
+		{name: "Should fail - email", value: "John <john@example.com>", ref: Use(Email), wantErr: true},
+		{name: "Should work - phone", value: "+5511999999999", ref: Use(Phone)},
+		{name: "Should fail - phone", value: "011 99999-9999", ref: Use(Phone), wantErr: true},
+		{name: "Should work - URL", value: "https://example.com/a?b=c", ref: Use(URL)},
+		{name: "Should fail - URL", value: "ftp://example.com", ref: Use(URL), wantErr: true},
+		{name: "Should work - regex", value: "AB-123", ref: Use(Regex, `^[A-Z]{2}-\d{3}$`)},
+		{name: "Should fail - regex", value: "ab-123", ref: Use(Regex, `^[A-Z]{2}-\d{3}$`), wantErr: true},
+		{name: "Should fail - invalid pattern", value: "a", ref: Use(Regex, `(`), wantErr: true},
+		{name: "Should work - CPF", value: "529.982.247-25", ref: Use(CPF)},
+		{name: "Should fail - CPF check digit", value: "529.982.247-26", ref: Use(CPF), wantErr: true},
+		{name: "Should fail - CPF repeated digits", value: "111.111.111-11", ref: Use(CPF), wantErr: true},
+		{name: "Should work - SSN", value: "123-45-6789", ref: Use(SSN)},
+		{name: "Should fail - SSN area", value: "666-45-6789", ref: Use(SSN), wantErr: true},
+		{name: "Should fail - SSN group", value: "123-00-6789", ref: Use(SSN), wantErr: true},
+		{name: "Should fail - not text", value: 42, ref: Use(Email), wantErr: true},
+		{name: "Should fail - not found", value: "a", ref: Use("unknown"), wantErr: true},
+		{name: "Should work - nil values aren't validated", value: nil, ref: Use(Email)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Run("q1", tt.value, tt.ref)
+
+			if !tt.wantErr {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.ErrorIs(t, err, errorcatalog.Catalog.MustGet(errorcatalog.ErrAnswerValidation))
+
+			var errs Errors
+
+			assert.True(t, errors.As(err, &errs))
+			assert.Len(t, errs, 1)
+			assert.Equal(t, "q1", errs[0].QuestionID)
+			assert.Equal(t, tt.ref.Name, errs[0].Validator)
+		})
+	}
+}
+
+func TestRegistry_Register(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "Should work",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRegistry()
+
+			even := func(value any, _ string) error {
+				if n, ok := value.(float64); !ok || int(n)%2 != 0 {
+					return errors.New("must be even")
+				}
+
+				return nil
+			}
+
+			assert.NoError(t, r.Register("even", even))
+			assert.Error(t, r.Register("even", even))
+			assert.Error(t, r.Register("", even))
+			assert.Error(t, r.Register("odd", nil))
+
+			assert.NoError(t, r.Validate(Use("even"), Use(Email)))
+			assert.Error(t, r.Validate(Use("odd")))
+
+			assert.NoError(t, r.Run("q1", 2.0, Use("even")))
+
+			err := r.Run("q1", 3.0, Ref{Message: "pick an even number", Name: "even"})
+			assert.Equal(t, Errors{{Message: "pick an even number", QuestionID: "q1", Validator: "even"}}, err)
+
+			// Registries are independent.
+			_, ok := Default.Get("even")
+			assert.False(t, ok)
+		})
+	}
+}